@@ -0,0 +1,160 @@
+package govalidator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRegionCode = errors.New("invalid region code")
+	ErrInvalidBirthdate  = errors.New("invalid birthdate")
+)
+
+var (
+	ErrInvalidCNIDCard   = errors.New("invalid chinese resident id card")
+	ErrInvalidCNUSCC     = errors.New("invalid chinese unified social credit code")
+	ErrInvalidCNMobile   = errors.New("invalid chinese mobile number")
+	ErrInvalidCNPostcode = errors.New("invalid chinese postcode")
+	ErrInvalidCNPlate    = errors.New("invalid chinese vehicle plate")
+)
+
+var (
+	rxCNMobile   = regexp.MustCompile(`^(?:\+?86)?1[3-9]\d{9}$`)
+	rxCNPostcode = regexp.MustCompile(`^[0-8]\d{5}$`)
+	rxCNPlate    = regexp.MustCompile(`^[京津沪渝冀豫云辽黑湘皖鲁新苏浙赣鄂桂甘晋蒙陕吉闽贵粤青藏川宁琼][A-HJ-NP-Z]` +
+		`(?:[A-HJ-NP-Z0-9]{4}[A-HJ-NP-Z0-9挂学警港澳]|[A-HJK][A-HJ-NP-Z0-9]\d{4}|\d{5}[A-HJK])$`)
+)
+
+// cnProvinceCodes are the first 2 digits of the administrative division codes of GB/T 2260.
+var cnProvinceCodes = newStringSet([]string{
+	"11", "12", "13", "14", "15", "21", "22", "23", "31", "32", "33", "34", "35", "36", "37",
+	"41", "42", "43", "44", "45", "46", "50", "51", "52", "53", "54", "61", "62", "63", "64", "65",
+	"71", "81", "82", "83",
+})
+
+var (
+	cnIDCardWeights = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	cnIDCardChecks  = "10X98765432"
+)
+
+// validCNBirthdate checks the birthdate in format 20060102 is a valid date after 1900 and not in future.
+func validCNBirthdate(date string) bool {
+	birth, err := time.ParseInLocation("20060102", date, time.Local)
+	if err != nil {
+		return false
+	}
+	return birth.Year() >= 1900 && !birth.After(Now())
+}
+
+// IsCNIDCard check if the string is a chinese resident id card number of 18 digits with the
+// GB 11643 check digit, or the legacy 15 digits. Empty string is valid.
+func IsCNIDCard(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	switch len(str) {
+	case 15:
+		if !isDigits(str) {
+			return checkError(ErrInvalidCNIDCard, ErrInvalidCharacters)
+		}
+		if !cnProvinceCodes.Contains(str[:2]) {
+			return checkError(ErrInvalidCNIDCard, ErrInvalidRegionCode)
+		}
+		if !validCNBirthdate("19" + str[6:12]) {
+			return checkError(ErrInvalidCNIDCard, ErrInvalidBirthdate)
+		}
+		return nil
+	case 18:
+		last := str[17]
+		if last == 'x' {
+			last = 'X'
+		}
+		if !isDigits(str[:17]) || (last != 'X' && (last < '0' || last > '9')) {
+			return checkError(ErrInvalidCNIDCard, ErrInvalidCharacters)
+		}
+		if !cnProvinceCodes.Contains(str[:2]) {
+			return checkError(ErrInvalidCNIDCard, ErrInvalidRegionCode)
+		}
+		if !validCNBirthdate(str[6:14]) {
+			return checkError(ErrInvalidCNIDCard, ErrInvalidBirthdate)
+		}
+		sum := 0
+		for i, weight := range cnIDCardWeights {
+			sum += int(str[i]-'0') * weight
+		}
+		if cnIDCardChecks[sum%11] != last {
+			return checkError(ErrInvalidCNIDCard, ErrChecksumMismatch)
+		}
+		return nil
+	}
+	return checkError(ErrInvalidCNIDCard, ErrWrongLength)
+}
+
+var (
+	cnUSCCChars   = "0123456789ABCDEFGHJKLMNPQRTUWXY"
+	cnUSCCWeights = []int{1, 3, 9, 27, 19, 26, 16, 17, 20, 29, 25, 13, 8, 24, 10, 30, 28}
+)
+
+// IsCNUSCC check if the string is a chinese unified social credit code of 18 characters with the
+// GB 32100 check character. Empty string is valid.
+func IsCNUSCC(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if len(str) != 18 {
+		return checkError(ErrInvalidCNUSCC, ErrWrongLength)
+	}
+
+	sum := 0
+	for i := 0; i < 18; i++ {
+		code := strings.IndexByte(cnUSCCChars, str[i])
+		if code == -1 {
+			return checkError(ErrInvalidCNUSCC, ErrInvalidCharacters)
+		}
+		if i < 17 {
+			sum += code * cnUSCCWeights[i]
+		}
+	}
+	if !isDigits(str[2:8]) {
+		return checkError(ErrInvalidCNUSCC, ErrInvalidRegionCode)
+	}
+	if check := (31 - sum%31) % 31; cnUSCCChars[check] != str[17] {
+		return checkError(ErrInvalidCNUSCC, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// IsCNMobile check if the string is a chinese mobile number of 11 digits, with optional +86 prefix.
+// Empty string is valid.
+func IsCNMobile(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxCNMobile.MatchString(str) {
+		return nil
+	}
+	return ErrInvalidCNMobile
+}
+
+// IsCNPostcode check if the string is a chinese postcode of 6 digits. Empty string is valid.
+func IsCNPostcode(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxCNPostcode.MatchString(str) {
+		return nil
+	}
+	return ErrInvalidCNPostcode
+}
+
+// IsCNPlate check if the string is a chinese vehicle plate number, including the new energy
+// plates of 8 characters like 粤BD12345. Empty string is valid.
+func IsCNPlate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxCNPlate.MatchString(str) {
+		return nil
+	}
+	return ErrInvalidCNPlate
+}
@@ -0,0 +1,112 @@
+package govalidator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCNIDCard(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"", nil},
+		{"11010519491231002X", nil},
+		{"11010519491231002x", nil},
+		{"440308200002291231", nil},
+		{"110105491231002", nil},
+		{"110105194912310021", ErrChecksumMismatch},
+		{"440308200102291234", ErrInvalidBirthdate},
+		{"110105189912310021", ErrInvalidBirthdate},
+		{"110105299912310021", ErrInvalidBirthdate},
+		{"99010519491231002X", ErrInvalidRegionCode},
+		{"1101051949123100AX", ErrInvalidCharacters},
+		{"110105491331002", ErrInvalidBirthdate},
+		{"1101051949123100", ErrWrongLength},
+	}
+	for _, test := range tests {
+		err := IsCNIDCard(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check IsCNIDCard(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check IsCNIDCard(%s)", test.param)
+			require.ErrorIs(t, err, ErrInvalidCNIDCard, "check IsCNIDCard(%s)", test.param)
+		}
+	}
+}
+
+func TestCNIDCardFutureBirthdate(t *testing.T) {
+	Now = func() time.Time { return time.Date(1999, 1, 1, 0, 0, 0, 0, time.Local) }
+	defer func() { Now = time.Now }()
+
+	require.ErrorIs(t, IsCNIDCard("440308200002291231"), ErrInvalidBirthdate)
+	require.NoError(t, IsCNIDCard("11010519491231002X"))
+}
+
+func TestIsCNUSCC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"", nil},
+		{"91350100M000100Y43", nil},
+		{"91110000600037341L", nil},
+		{"91350100M000100Y44", ErrChecksumMismatch},
+		{"91350100M000100Y4", ErrWrongLength},
+		{"9135010OM000100Y43", ErrInvalidCharacters},
+		{"91A50100M000100Y43", ErrInvalidRegionCode},
+	}
+	for _, test := range tests {
+		err := IsCNUSCC(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check IsCNUSCC(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check IsCNUSCC(%s)", test.param)
+		}
+	}
+}
+
+func TestCNFormats(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		validator func(interface{}, ...string) error
+		param     string
+		expected  bool
+	}{
+		{IsCNMobile, "", true},
+		{IsCNMobile, "13800138000", true},
+		{IsCNMobile, "+8619912345678", true},
+		{IsCNMobile, "8615912345678", true},
+		{IsCNMobile, "12800138000", false},
+		{IsCNMobile, "1380013800", false},
+		{IsCNMobile, "138-0013-8000", false},
+		{IsCNPostcode, "100000", true},
+		{IsCNPostcode, "518000", true},
+		{IsCNPostcode, "900000", false},
+		{IsCNPostcode, "10000", false},
+		{IsCNPlate, "京A12345", true},
+		{IsCNPlate, "粤B8888学", true},
+		{IsCNPlate, "沪AD12345", true},
+		{IsCNPlate, "浙AF1234A", false},
+		{IsCNPlate, "浙A12345F", true},
+		{IsCNPlate, "苏E1234挂", true},
+		{IsCNPlate, "京I12345", false},
+		{IsCNPlate, "京A1234", false},
+		{IsCNPlate, "XA12345", false},
+	}
+	for _, test := range tests {
+		err := test.validator(test.param)
+		if test.expected {
+			require.NoError(t, err, "check %s", test.param)
+		} else {
+			require.Error(t, err, "check %s", test.param)
+		}
+	}
+}
@@ -0,0 +1,395 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// The errors of the failed checks, wrapped by the errors of the validators,
+// e.g. errors.Is(err, ErrInvalidIBAN) and errors.Is(err, ErrChecksumMismatch) are both true.
+var (
+	ErrInvalidCharacters   = errors.New("invalid characters")
+	ErrWrongLength         = errors.New("wrong length")
+	ErrInvalidPrefix       = errors.New("invalid prefix")
+	ErrChecksumMismatch    = errors.New("checksum mismatch")
+	ErrUnknownCardBrand    = errors.New("unknown card brand")
+	ErrCardBrandNotAllowed = errors.New("card brand not allowed")
+	ErrUnknownIBANCountry  = errors.New("unknown IBAN country")
+)
+
+var (
+	ErrInvalidLuhn       = errors.New("invalid luhn number")
+	ErrInvalidCreditCard = errors.New("invalid credit card")
+	ErrInvalidIBAN       = errors.New("invalid IBAN")
+	ErrInvalidBIC        = errors.New("invalid BIC")
+	ErrInvalidISBN10     = errors.New("invalid ISBN-10")
+	ErrInvalidISBN13     = errors.New("invalid ISBN-13")
+	ErrInvalidEAN8       = errors.New("invalid EAN-8")
+	ErrInvalidEAN13      = errors.New("invalid EAN-13")
+	ErrInvalidUPC        = errors.New("invalid UPC")
+)
+
+// checkError wraps the failed check error with the validator error.
+func checkError(invalid error, check error) error {
+	return fmt.Errorf("%w: %w", invalid, check)
+}
+
+func ErrUnknownCardBrandName(name string) error {
+	return fmt.Errorf("unknown card brand name: %v", name)
+}
+
+// isDigits check if the string is not empty and contains only ASCII digits.
+func isDigits(str string) bool {
+	if str == "" {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// luhnValid check the Luhn checksum of the digits string.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// gtinValid check the GS1 checksum of EAN-8, EAN-13, UPC-A and ISBN-13 digits string.
+func gtinValid(digits string) bool {
+	sum := 0
+	for i := len(digits) - 2; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(digits[len(digits)-1]-'0')
+}
+
+var separatorReplacer = strings.NewReplacer(" ", "", "-", "")
+
+// stripSeparators removes the spaces and hyphens used to group the digits.
+func stripSeparators(str string) string {
+	return separatorReplacer.Replace(str)
+}
+
+// IsLuhn check if the string is digits with valid Luhn checksum. Empty string is valid.
+func IsLuhn(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if !isDigits(str) {
+		return checkError(ErrInvalidLuhn, ErrInvalidCharacters)
+	}
+	if len(str) < 2 {
+		return checkError(ErrInvalidLuhn, ErrWrongLength)
+	}
+	if !luhnValid(str) {
+		return checkError(ErrInvalidLuhn, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// cardBrand is the issuer identification number ranges and lengths of the card brand.
+type cardBrand struct {
+	name    string
+	ranges  [][2]string
+	lengths []int
+}
+
+func (b *cardBrand) match(number string) bool {
+	for _, r := range b.ranges {
+		if len(number) < len(r[0]) {
+			continue
+		}
+		prefix := number[:len(r[0])]
+		if prefix >= r[0] && prefix <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *cardBrand) validLength(n int) bool {
+	for _, length := range b.lengths {
+		if n == length {
+			return true
+		}
+	}
+	return false
+}
+
+// cardBrands are the card brands ordered from the narrower ranges to the wider ones.
+var cardBrands = []*cardBrand{
+	{"amex", [][2]string{{"34", "34"}, {"37", "37"}}, []int{15}},
+	{"diners", [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}}, []int{14, 15, 16, 17, 18, 19}},
+	{"jcb", [][2]string{{"3528", "3589"}}, []int{16, 17, 18, 19}},
+	{"mir", [][2]string{{"2200", "2204"}}, []int{16, 17, 18, 19}},
+	{"mastercard", [][2]string{{"51", "55"}, {"2221", "2720"}}, []int{16}},
+	{"discover", [][2]string{{"6011", "6011"}, {"622126", "622925"}, {"644", "649"}, {"65", "65"}}, []int{16, 17, 18, 19}},
+	{"unionpay", [][2]string{{"62", "62"}, {"81", "81"}}, []int{16, 17, 18, 19}},
+	{"maestro", [][2]string{{"50", "50"}, {"56", "58"}, {"639", "639"}, {"67", "67"}}, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{"visa", [][2]string{{"4", "4"}}, []int{13, 16, 19}},
+}
+
+// CardBrands are the names of the supported card brands.
+var CardBrands = func() []string {
+	names := make([]string, 0, len(cardBrands))
+	for _, brand := range cardBrands {
+		names = append(names, brand.name)
+	}
+	return names
+}()
+
+func findCardBrand(number string) *cardBrand {
+	for _, brand := range cardBrands {
+		if brand.match(number) {
+			return brand
+		}
+	}
+	return nil
+}
+
+// DetectCardBrand returns the brand name of the card number like visa, mastercard,
+// or empty string if unknown. The spaces and hyphens in number are ignored.
+func DetectCardBrand(number string) string {
+	if brand := findCardBrand(stripSeparators(number)); brand != nil {
+		return brand.name
+	}
+	return ""
+}
+
+// CreditCardValidator checks the card number has a known brand, valid length and Luhn checksum,
+// the spaces and hyphens in number are ignored. If Brands is not empty, only the brands are allowed.
+type CreditCardValidator struct {
+	Brands []string
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *CreditCardValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	number := stripSeparators(str)
+	if !isDigits(number) {
+		return checkError(ErrInvalidCreditCard, ErrInvalidCharacters)
+	}
+	brand := findCardBrand(number)
+	if brand == nil {
+		return checkError(ErrInvalidCreditCard, ErrUnknownCardBrand)
+	}
+	if !brand.validLength(len(number)) {
+		return checkError(ErrInvalidCreditCard, ErrWrongLength)
+	}
+	if !luhnValid(number) {
+		return checkError(ErrInvalidCreditCard, ErrChecksumMismatch)
+	}
+	if len(v.Brands) > 0 && !containsString(v.Brands, brand.name) {
+		return checkError(ErrInvalidCreditCard, ErrCardBrandNotAllowed)
+	}
+	return nil
+}
+
+// CompileCreditCard creates the validator of tag `credit_card` or `credit_card(visa,mastercard)`.
+func CompileCreditCard(args ...string) Validator {
+	for _, arg := range args {
+		if !containsString(CardBrands, arg) {
+			panic(ErrUnknownCardBrandName(arg))
+		}
+	}
+	return &CreditCardValidator{Brands: args}
+}
+
+// IBANLengths are the IBAN lengths of the countries in the IBAN registry.
+var IBANLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HN": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26,
+	"IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21,
+	"LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28,
+	"NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22,
+	"RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25,
+	"SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// IsIBAN check if the string is a valid uppercase IBAN, with the country length and mod-97 checksum.
+// The spaces of the printed format are ignored. Empty string is valid.
+func IsIBAN(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	iban := strings.ReplaceAll(str, " ", "")
+	if len(iban) < 5 {
+		return checkError(ErrInvalidIBAN, ErrWrongLength)
+	}
+	for i := 0; i < len(iban); i++ {
+		c := iban[i]
+		isLetter := 'A' <= c && c <= 'Z'
+		isDigit := '0' <= c && c <= '9'
+		if (i < 2 && !isLetter) || (i >= 2 && i < 4 && !isDigit) || (!isLetter && !isDigit) {
+			return checkError(ErrInvalidIBAN, ErrInvalidCharacters)
+		}
+	}
+
+	length, ok := IBANLengths[iban[:2]]
+	if !ok {
+		return checkError(ErrInvalidIBAN, ErrUnknownIBANCountry)
+	}
+	if len(iban) != length {
+		return checkError(ErrInvalidIBAN, ErrWrongLength)
+	}
+
+	// move the country code and check digits to the end, letters are 10 to 35
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		if c >= 'A' {
+			remainder = (remainder*100 + int(c-'A'+10)) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	if remainder != 1 {
+		return checkError(ErrInvalidIBAN, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// IsBIC check if the string is a valid BIC (SWIFT code) of 8 or 11 characters:
+// 4 letters of bank, 2 letters of country, 2 alphanumerics of location and optional 3 of branch.
+// Empty string is valid.
+func IsBIC(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if len(str) != 8 && len(str) != 11 {
+		return checkError(ErrInvalidBIC, ErrWrongLength)
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		isLetter := 'A' <= c && c <= 'Z'
+		if (i < 6 && !isLetter) || (i >= 6 && !isLetter && !('0' <= c && c <= '9')) {
+			return checkError(ErrInvalidBIC, ErrInvalidCharacters)
+		}
+	}
+	return nil
+}
+
+// IsISBN10 check if the string is a valid ISBN-10, the last check digit can be X.
+// The spaces and hyphens are ignored. Empty string is valid.
+func IsISBN10(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	isbn := stripSeparators(str)
+	if len(isbn) != 10 {
+		return checkError(ErrInvalidISBN10, ErrWrongLength)
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := isbn[i]
+		var d int
+		switch {
+		case '0' <= c && c <= '9':
+			d = int(c - '0')
+		case i == 9 && (c == 'X' || c == 'x'):
+			d = 10
+		default:
+			return checkError(ErrInvalidISBN10, ErrInvalidCharacters)
+		}
+		sum += d * (10 - i)
+	}
+	if sum%11 != 0 {
+		return checkError(ErrInvalidISBN10, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// IsISBN13 check if the string is a valid ISBN-13 with prefix 978 or 979.
+// The spaces and hyphens are ignored. Empty string is valid.
+func IsISBN13(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	isbn := stripSeparators(str)
+	if !isDigits(isbn) {
+		return checkError(ErrInvalidISBN13, ErrInvalidCharacters)
+	}
+	if len(isbn) != 13 {
+		return checkError(ErrInvalidISBN13, ErrWrongLength)
+	}
+	if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return checkError(ErrInvalidISBN13, ErrInvalidPrefix)
+	}
+	if !gtinValid(isbn) {
+		return checkError(ErrInvalidISBN13, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// validateGTIN checks the digits, length and GS1 checksum of the GTIN string.
+func validateGTIN(value interface{}, length int, invalid error) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if !isDigits(str) {
+		return checkError(invalid, ErrInvalidCharacters)
+	}
+	if len(str) != length {
+		return checkError(invalid, ErrWrongLength)
+	}
+	if !gtinValid(str) {
+		return checkError(invalid, ErrChecksumMismatch)
+	}
+	return nil
+}
+
+// IsEAN8 check if the string is a valid EAN-8 barcode. Empty string is valid.
+func IsEAN8(value interface{}, args ...string) error {
+	return validateGTIN(value, 8, ErrInvalidEAN8)
+}
+
+// IsEAN13 check if the string is a valid EAN-13 barcode. Empty string is valid.
+func IsEAN13(value interface{}, args ...string) error {
+	return validateGTIN(value, 13, ErrInvalidEAN13)
+}
+
+// IsUPC check if the string is a valid UPC-A barcode of 12 digits. Empty string is valid.
+func IsUPC(value interface{}, args ...string) error {
+	return validateGTIN(value, 12, ErrInvalidUPC)
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("credit_card", CompileCreditCard)
+}
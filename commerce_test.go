@@ -0,0 +1,183 @@
+package govalidator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsLuhn(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"", nil},
+		{"79927398713", nil},
+		{"0", ErrWrongLength},
+		{"79927398710", ErrChecksumMismatch},
+		{"7992 7398 713", ErrInvalidCharacters},
+	}
+	for _, test := range tests {
+		err := IsLuhn(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check IsLuhn(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check IsLuhn(%s)", test.param)
+			require.ErrorIs(t, err, ErrInvalidLuhn, "check IsLuhn(%s)", test.param)
+		}
+	}
+}
+
+func TestCreditCard(t *testing.T) {
+	t.Parallel()
+
+	var brands = []struct {
+		number string
+		brand  string
+	}{
+		{"4111111111111111", "visa"},
+		{"4111 1111 1111 1111", "visa"},
+		{"5555555555554444", "mastercard"},
+		{"2223003122003222", "mastercard"},
+		{"378282246310005", "amex"},
+		{"6011111111111117", "discover"},
+		{"3530111333300000", "jcb"},
+		{"30569309025904", "diners"},
+		{"6200000000000005", "unionpay"},
+		{"9999999999999995", ""},
+	}
+	for _, test := range brands {
+		require.Equal(t, test.brand, DetectCardBrand(test.number), "check DetectCardBrand(%s)", test.number)
+	}
+
+	all := CompileCreditCard()
+	visaMC := CompileCreditCard("visa", "mastercard")
+
+	var tests = []struct {
+		validator Validator
+		param     string
+		expected  error
+	}{
+		{all, "", nil},
+		{all, "4111111111111111", nil},
+		{all, "4111-1111-1111-1111", nil},
+		{all, "378282246310005", nil},
+		{all, "4111111111111112", ErrChecksumMismatch},
+		{all, "411111111111111", ErrWrongLength},
+		{all, "9999999999999995", ErrUnknownCardBrand},
+		{all, "4111x11111111111", ErrInvalidCharacters},
+		{visaMC, "5555555555554444", nil},
+		{visaMC, "378282246310005", ErrCardBrandNotAllowed},
+	}
+	for _, test := range tests {
+		err := test.validator.Validate(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check credit_card(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check credit_card(%s)", test.param)
+			require.ErrorIs(t, err, ErrInvalidCreditCard, "check credit_card(%s)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompileCreditCard("paypal") })
+}
+
+func TestIsIBAN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"", nil},
+		{"GB82WEST12345698765432", nil},
+		{"GB82 WEST 1234 5698 7654 32", nil},
+		{"DE89370400440532013000", nil},
+		{"FR1420041010050500013M02606", nil},
+		{"NO9386011117947", nil},
+		{"GB82WEST12345698765431", ErrChecksumMismatch},
+		{"GB82WEST1234569876543", ErrWrongLength},
+		{"ZZ82WEST12345698765432", ErrUnknownIBANCountry},
+		{"gb82west12345698765432", ErrInvalidCharacters},
+		{"GBXXWEST12345698765432", ErrInvalidCharacters},
+		{"GB8", ErrWrongLength},
+	}
+	for _, test := range tests {
+		err := IsIBAN(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check IsIBAN(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check IsIBAN(%s)", test.param)
+			require.ErrorIs(t, err, ErrInvalidIBAN, "check IsIBAN(%s)", test.param)
+		}
+	}
+}
+
+func TestIsBIC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected error
+	}{
+		{"", nil},
+		{"DEUTDEFF", nil},
+		{"DEUTDEFF500", nil},
+		{"NEDSZAJJXXX", nil},
+		{"DEUTDEF", ErrWrongLength},
+		{"DEUTDEFF5", ErrWrongLength},
+		{"DEU1DEFF", ErrInvalidCharacters},
+		{"deutdeff", ErrInvalidCharacters},
+	}
+	for _, test := range tests {
+		err := IsBIC(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check IsBIC(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check IsBIC(%s)", test.param)
+		}
+	}
+}
+
+func TestISBNAndGTIN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		validator func(interface{}, ...string) error
+		param     string
+		expected  error
+	}{
+		{IsISBN10, "", nil},
+		{IsISBN10, "0306406152", nil},
+		{IsISBN10, "0-8044-2957-X", nil},
+		{IsISBN10, "0306406153", ErrChecksumMismatch},
+		{IsISBN10, "030640615", ErrWrongLength},
+		{IsISBN10, "X306406152", ErrInvalidCharacters},
+		{IsISBN13, "9780306406157", nil},
+		{IsISBN13, "978-3-16-148410-0", nil},
+		{IsISBN13, "9780306406158", ErrChecksumMismatch},
+		{IsISBN13, "4006381333931", ErrInvalidPrefix},
+		{IsISBN13, "978030640615", ErrWrongLength},
+		{IsEAN13, "4006381333931", nil},
+		{IsEAN13, "4006381333932", ErrChecksumMismatch},
+		{IsEAN13, "400638133393", ErrWrongLength},
+		{IsEAN8, "96385074", nil},
+		{IsEAN8, "96385075", ErrChecksumMismatch},
+		{IsUPC, "036000291452", nil},
+		{IsUPC, "036000291453", ErrChecksumMismatch},
+		{IsUPC, "03600029145a", ErrInvalidCharacters},
+	}
+	for _, test := range tests {
+		err := test.validator(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check %s", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check %s", test.param)
+		}
+	}
+
+	require.True(t, errors.Is(IsEAN8("96385075"), ErrInvalidEAN8))
+}
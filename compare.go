@@ -0,0 +1,206 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Now returns the current time, used by the time comparisons with `now`, e.g. `gt(now)`.
+// It can be replaced in tests.
+var Now = time.Now
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+func ErrNotGreaterThan(value interface{}, arg string) error {
+	return fmt.Errorf("should be greater than %v, but got %v", arg, value)
+}
+
+func ErrNotGreaterOrEqual(value interface{}, arg string) error {
+	return fmt.Errorf("should be greater than or equal to %v, but got %v", arg, value)
+}
+
+func ErrNotLessThan(value interface{}, arg string) error {
+	return fmt.Errorf("should be less than %v, but got %v", arg, value)
+}
+
+func ErrNotLessOrEqual(value interface{}, arg string) error {
+	return fmt.Errorf("should be less than or equal to %v, but got %v", arg, value)
+}
+
+func ErrNotEqual(value interface{}, arg string) error {
+	return fmt.Errorf("should be equal to %v, but got %v", arg, value)
+}
+
+func ErrIsEqual(value interface{}, arg string) error {
+	return fmt.Errorf("should not be equal to %v", arg)
+}
+
+func ErrInvalidTimeArg(funcName string, arg string) error {
+	return fmt.Errorf("function %v invalid time argument: %v", funcName, arg)
+}
+
+// parseTimeArg parses the time argument of tag, which is RFC3339 time, date like 2006-01-02,
+// `now` or the time relative to now like `now+24h`, `now-1h30m`.
+func parseTimeArg(funcName string, arg string) time.Time {
+	if strings.HasPrefix(arg, "now") {
+		now := Now()
+		offset := arg[len("now"):]
+		if offset == "" {
+			return now
+		}
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			panic(ErrInvalidTimeArg(funcName, arg))
+		}
+		return now.Add(d)
+	}
+	if t, err := time.Parse(time.RFC3339, arg); err == nil {
+		return t
+	}
+	if t, err := time.Parse("2006-01-02", arg); err == nil {
+		return t
+	}
+	panic(ErrInvalidTimeArg(funcName, arg))
+}
+
+// compareValue compares the value with the argument of tag, returns -1, 0 or +1.
+// The time.Time is compared with the time argument, the time.Duration is compared with the
+// duration argument like 1h30m, the strings are always compared lexicographically, so "10" is
+// less than "9", use min, max or range to compare the numeric strings. The numbers are compared
+// as Min and Max do. The ok is false if the value is empty string or zero time.
+func compareValue(funcName string, value interface{}, arg string) (cmp int, ok bool, err error) {
+	arg = strings.TrimSpace(arg)
+	if _, isNumber := value.(json.Number); isNumber {
+		return compareNumber(funcName, value, arg)
+	}
+
+	val := reflect.ValueOf(value)
+	switch {
+	case val.Kind() == reflect.Struct && val.Type().ConvertibleTo(timeType):
+		t := val.Convert(timeType).Interface().(time.Time)
+		if t.IsZero() {
+			return 0, false, nil
+		}
+		return t.Compare(parseTimeArg(funcName, arg)), true, nil
+	case val.IsValid() && val.Type() == durationType:
+		d, err := time.ParseDuration(arg)
+		if err != nil {
+			panic(ErrInvalidNumberArg(funcName, arg))
+		}
+		return compareDuration(time.Duration(val.Int()), d), true, nil
+	case val.Kind() == reflect.String:
+		str := val.String()
+		if str == "" {
+			return 0, false, nil
+		}
+		return strings.Compare(str, arg), true, nil
+	}
+	return compareNumber(funcName, value, arg)
+}
+
+func compareDuration(a, b time.Duration) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// GreaterThan check if the value is greater than the argument. Empty string and zero time are valid.
+func GreaterThan(value interface{}, args ...string) error {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("gt", 1))
+	}
+	cmp, ok, err := compareValue("gt", value, args[0])
+	if err != nil || !ok || cmp > 0 {
+		return err
+	}
+	return ErrNotGreaterThan(value, args[0])
+}
+
+// GreaterOrEqual check if the value is greater than or equal to the argument.
+// Empty string and zero time are valid.
+func GreaterOrEqual(value interface{}, args ...string) error {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("gte", 1))
+	}
+	cmp, ok, err := compareValue("gte", value, args[0])
+	if err != nil || !ok || cmp >= 0 {
+		return err
+	}
+	return ErrNotGreaterOrEqual(value, args[0])
+}
+
+// LessThan check if the value is less than the argument. Empty string and zero time are valid.
+func LessThan(value interface{}, args ...string) error {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("lt", 1))
+	}
+	cmp, ok, err := compareValue("lt", value, args[0])
+	if err != nil || !ok || cmp < 0 {
+		return err
+	}
+	return ErrNotLessThan(value, args[0])
+}
+
+// LessOrEqual check if the value is less than or equal to the argument.
+// Empty string and zero time are valid.
+func LessOrEqual(value interface{}, args ...string) error {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("lte", 1))
+	}
+	cmp, ok, err := compareValue("lte", value, args[0])
+	if err != nil || !ok || cmp <= 0 {
+		return err
+	}
+	return ErrNotLessOrEqual(value, args[0])
+}
+
+// Equal check if the value is equal to the argument. Empty string and zero time are valid.
+func Equal(value interface{}, args ...string) error {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("eq", 1))
+	}
+	cmp, ok, err := compareValue("eq", value, args[0])
+	if err != nil || !ok || cmp == 0 {
+		return err
+	}
+	return ErrNotEqual(value, args[0])
+}
+
+// NotEqual check if the value is not equal to the argument. Empty string and zero time are valid.
+func NotEqual(value interface{}, args ...string) error {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("ne", 1))
+	}
+	cmp, ok, err := compareValue("ne", value, args[0])
+	if err != nil || !ok || cmp != 0 {
+		return err
+	}
+	return ErrIsEqual(value, args[0])
+}
+
+// parseInterval parses the bounds of range, `(0` and `1)` are exclusive, `[0`, `1]` and
+// the plain numbers are inclusive.
+func parseInterval(lower, upper string) (min string, minExclusive bool, max string, maxExclusive bool) {
+	min, max = strings.TrimSpace(lower), strings.TrimSpace(upper)
+	if strings.HasPrefix(min, "(") {
+		min, minExclusive = min[1:], true
+	} else {
+		min = strings.TrimPrefix(min, "[")
+	}
+	if strings.HasSuffix(max, ")") {
+		max, maxExclusive = max[:len(max)-1], true
+	} else {
+		max = strings.TrimSuffix(max, "]")
+	}
+	return
+}
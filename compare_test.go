@@ -0,0 +1,132 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		value interface{}
+		arg   string
+		cmp   int
+	}{
+		{5, "3", 1},
+		{3, "3", 0},
+		{-1, "3", -1},
+		{uint16(7), "7", 0},
+		{2.5, "2.4", 1},
+		{float32(0.1), "0.1", 0},
+		{json.Number("10"), "9", 1},
+		{"b", "a", 1},
+		{"10", "9", -1},
+		{"9.5", "10", 1},
+		{"b10", "b9", -1},
+		{"abc", "abc", 0},
+		{day, "2024-01-01", 1},
+		{day, "2024-01-02T00:00:00Z", 0},
+		{day, "2024-01-02T08:00:00+08:00", 0},
+		{day, "2025-01-01", -1},
+		{90 * time.Minute, "1h", 1},
+		{time.Hour, "60m", 0},
+		{time.Second, "1m", -1},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%v, %v)", name, test.value, test.arg)
+			} else {
+				require.Error(t, err, "check %s(%v, %v)", name, test.value, test.arg)
+			}
+		}
+		check(GreaterThan(test.value, test.arg), test.cmp > 0, "gt")
+		check(GreaterOrEqual(test.value, test.arg), test.cmp >= 0, "gte")
+		check(LessThan(test.value, test.arg), test.cmp < 0, "lt")
+		check(LessOrEqual(test.value, test.arg), test.cmp <= 0, "lte")
+		check(Equal(test.value, test.arg), test.cmp == 0, "eq")
+		check(NotEqual(test.value, test.arg), test.cmp != 0, "ne")
+	}
+
+	require.NoError(t, GreaterThan("", "a"))
+	require.NoError(t, GreaterThan("abc", "9"))
+	require.NoError(t, Min("10", "9"))
+	require.NoError(t, GreaterThan(time.Time{}, "2024-01-01"))
+	require.ErrorIs(t, GreaterThan(math.NaN(), "0"), ErrIsNaN)
+	require.EqualError(t, GreaterOrEqual(1, "2"), "should be greater than or equal to 2, but got 1")
+	require.EqualError(t, Min(1, "2"), "should be greater than or equal to 2, but got 1")
+	require.Panics(t, func() { _ = GreaterThan(day, "yesterday") })
+	require.Panics(t, func() { _ = GreaterThan(time.Second, "1") })
+}
+
+func TestCompareNow(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	Now = func() time.Time { return now }
+	defer func() { Now = time.Now }()
+
+	require.NoError(t, GreaterThan(now.Add(time.Second), "now"))
+	require.Error(t, GreaterThan(now, "now"))
+	require.NoError(t, LessThan(now.Add(23*time.Hour), "now+24h"))
+	require.Error(t, GreaterOrEqual(now.Add(-2*time.Hour), "now-1h"))
+}
+
+func TestRangeInterval(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		value    interface{}
+		min      string
+		max      string
+		expected bool
+	}{
+		{0, "0", "1", true},
+		{1, "0", "1", true},
+		{0.0, "(0", "1]", false},
+		{1.0, "(0", "1]", true},
+		{0.5, "(0", "1]", true},
+		{0.0, "[0", "1)", true},
+		{1.0, "[0", "1)", false},
+		{0, "(0", "1)", false},
+		{1, "(0", "1)", false},
+		{"0.5", "(0", "1)", true},
+	}
+	for _, test := range tests {
+		err := Range(test.value, test.min, test.max)
+		if test.expected {
+			require.NoError(t, err, "check Range(%v, %v, %v)", test.value, test.min, test.max)
+		} else {
+			require.Error(t, err, "check Range(%v, %v, %v)", test.value, test.min, test.max)
+		}
+	}
+	require.EqualError(t, Range(0, "(0", "1]"), "should in range (0, 1], but got 0")
+}
+
+func TestCompareStruct(t *testing.T) {
+	t.Parallel()
+
+	type Job struct {
+		Ratio    float64       `valid:"range(0,1]"`
+		Weight   float64       `valid:"range[0,1)"`
+		Score    float64       `valid:"range((0,100))"`
+		Priority int           `valid:"gt(0);lte(10)"`
+		Timeout  time.Duration `valid:"gte(1s);lt(1h)"`
+		Version  string        `valid:"ne(v0)"`
+		Code     string        `valid:"regex(^(a|b)+$)"`
+	}
+
+	require.NoError(t, ValidateStruct(&Job{Ratio: 1, Weight: 0, Score: 50, Priority: 10, Timeout: time.Minute, Version: "v1", Code: "ab"}))
+
+	err := ValidateStruct(&Job{Ratio: 0, Weight: 1, Score: 100, Priority: 0, Timeout: time.Hour, Version: "v0", Code: "c"})
+	require.Error(t, err)
+	errs := err.(Errors)
+	for _, field := range []string{"Ratio", "Weight", "Score", "Priority", "Timeout", "Version", "Code"} {
+		require.True(t, errs.HasField(field), "check field %s", field)
+	}
+}
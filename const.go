@@ -1,31 +1,201 @@
 package govalidator
 
+// Currency is the ISO 4217 currency, MinorUnits is the number of digits after the decimal
+// separator, -1 if not applicable like XAU.
+type Currency struct {
+	Code       string
+	Numeric    string
+	MinorUnits int
+}
+
+// ISO4217Currencies is the list of the active ISO 4217 currencies
+var ISO4217Currencies = []Currency{
+	{"AED", "784", 2}, {"AFN", "971", 2}, {"ALL", "008", 2}, {"AMD", "051", 2}, {"AOA", "973", 2},
+	{"ARS", "032", 2}, {"AUD", "036", 2}, {"AWG", "533", 2}, {"AZN", "944", 2},
+	{"BAM", "977", 2}, {"BBD", "052", 2}, {"BDT", "050", 2}, {"BGN", "975", 2}, {"BHD", "048", 3},
+	{"BIF", "108", 0}, {"BMD", "060", 2}, {"BND", "096", 2}, {"BOB", "068", 2}, {"BOV", "984", 2},
+	{"BRL", "986", 2}, {"BSD", "044", 2}, {"BTN", "064", 2}, {"BWP", "072", 2}, {"BYN", "933", 2},
+	{"BZD", "084", 2},
+	{"CAD", "124", 2}, {"CDF", "976", 2}, {"CHE", "947", 2}, {"CHF", "756", 2}, {"CHW", "948", 2},
+	{"CLF", "990", 4}, {"CLP", "152", 0}, {"CNY", "156", 2}, {"COP", "170", 2}, {"COU", "970", 2},
+	{"CRC", "188", 2}, {"CUP", "192", 2}, {"CVE", "132", 2}, {"CZK", "203", 2},
+	{"DJF", "262", 0}, {"DKK", "208", 2}, {"DOP", "214", 2}, {"DZD", "012", 2},
+	{"EGP", "818", 2}, {"ERN", "232", 2}, {"ETB", "230", 2}, {"EUR", "978", 2},
+	{"FJD", "242", 2}, {"FKP", "238", 2},
+	{"GBP", "826", 2}, {"GEL", "981", 2}, {"GHS", "936", 2}, {"GIP", "292", 2}, {"GMD", "270", 2},
+	{"GNF", "324", 0}, {"GTQ", "320", 2}, {"GYD", "328", 2},
+	{"HKD", "344", 2}, {"HNL", "340", 2}, {"HTG", "332", 2}, {"HUF", "348", 2},
+	{"IDR", "360", 2}, {"ILS", "376", 2}, {"INR", "356", 2}, {"IQD", "368", 3}, {"IRR", "364", 2},
+	{"ISK", "352", 0},
+	{"JMD", "388", 2}, {"JOD", "400", 3}, {"JPY", "392", 0},
+	{"KES", "404", 2}, {"KGS", "417", 2}, {"KHR", "116", 2}, {"KMF", "174", 0}, {"KPW", "408", 2},
+	{"KRW", "410", 0}, {"KWD", "414", 3}, {"KYD", "136", 2}, {"KZT", "398", 2},
+	{"LAK", "418", 2}, {"LBP", "422", 2}, {"LKR", "144", 2}, {"LRD", "430", 2}, {"LSL", "426", 2},
+	{"LYD", "434", 3},
+	{"MAD", "504", 2}, {"MDL", "498", 2}, {"MGA", "969", 2}, {"MKD", "807", 2}, {"MMK", "104", 2},
+	{"MNT", "496", 2}, {"MOP", "446", 2}, {"MRU", "929", 2}, {"MUR", "480", 2}, {"MVR", "462", 2},
+	{"MWK", "454", 2}, {"MXN", "484", 2}, {"MXV", "979", 2}, {"MYR", "458", 2}, {"MZN", "943", 2},
+	{"NAD", "516", 2}, {"NGN", "566", 2}, {"NIO", "558", 2}, {"NOK", "578", 2}, {"NPR", "524", 2},
+	{"NZD", "554", 2},
+	{"OMR", "512", 3},
+	{"PAB", "590", 2}, {"PEN", "604", 2}, {"PGK", "598", 2}, {"PHP", "608", 2}, {"PKR", "586", 2},
+	{"PLN", "985", 2}, {"PYG", "600", 0},
+	{"QAR", "634", 2},
+	{"RON", "946", 2}, {"RSD", "941", 2}, {"RUB", "643", 2}, {"RWF", "646", 0},
+	{"SAR", "682", 2}, {"SBD", "090", 2}, {"SCR", "690", 2}, {"SDG", "938", 2}, {"SEK", "752", 2},
+	{"SGD", "702", 2}, {"SHP", "654", 2}, {"SLE", "925", 2}, {"SOS", "706", 2}, {"SRD", "968", 2},
+	{"SSP", "728", 2}, {"STN", "930", 2}, {"SVC", "222", 2}, {"SYP", "760", 2}, {"SZL", "748", 2},
+	{"THB", "764", 2}, {"TJS", "972", 2}, {"TMT", "934", 2}, {"TND", "788", 3}, {"TOP", "776", 2},
+	{"TRY", "949", 2}, {"TTD", "780", 2}, {"TWD", "901", 2}, {"TZS", "834", 2},
+	{"UAH", "980", 2}, {"UGX", "800", 0}, {"USD", "840", 2}, {"USN", "997", 2}, {"UYI", "940", 0},
+	{"UYU", "858", 2}, {"UYW", "927", 4}, {"UZS", "860", 2},
+	{"VED", "926", 2}, {"VES", "928", 2}, {"VND", "704", 0}, {"VUV", "548", 0},
+	{"WST", "882", 2},
+	{"XAF", "950", 0}, {"XAG", "961", -1}, {"XAU", "959", -1}, {"XBA", "955", -1}, {"XBB", "956", -1},
+	{"XBC", "957", -1}, {"XBD", "958", -1}, {"XCD", "951", 2}, {"XCG", "532", 2}, {"XDR", "960", -1},
+	{"XOF", "952", 0}, {"XPD", "964", -1}, {"XPF", "953", 0}, {"XPT", "962", -1}, {"XSU", "994", -1},
+	{"XTS", "963", -1}, {"XUA", "965", -1}, {"XXX", "999", -1},
+	{"YER", "886", 2},
+	{"ZAR", "710", 2}, {"ZMW", "967", 2}, {"ZWG", "924", 2},
+}
+
 // ISO4217List is the list of ISO currency codes
-var ISO4217List = []string{
-	"AED", "AFN", "ALL", "AMD", "ANG", "AOA", "ARS", "AUD", "AWG", "AZN",
-	"BAM", "BBD", "BDT", "BGN", "BHD", "BIF", "BMD", "BND", "BOB", "BOV", "BRL", "BSD", "BTN", "BWP", "BYN", "BZD",
-	"CAD", "CDF", "CHE", "CHF", "CHW", "CLF", "CLP", "CNY", "COP", "COU", "CRC", "CUC", "CUP", "CVE", "CZK",
-	"DJF", "DKK", "DOP", "DZD",
-	"EGP", "ERN", "ETB", "EUR",
-	"FJD", "FKP",
-	"GBP", "GEL", "GHS", "GIP", "GMD", "GNF", "GTQ", "GYD",
-	"HKD", "HNL", "HRK", "HTG", "HUF",
-	"IDR", "ILS", "INR", "IQD", "IRR", "ISK",
-	"JMD", "JOD", "JPY",
-	"KES", "KGS", "KHR", "KMF", "KPW", "KRW", "KWD", "KYD", "KZT",
-	"LAK", "LBP", "LKR", "LRD", "LSL", "LYD",
-	"MAD", "MDL", "MGA", "MKD", "MMK", "MNT", "MOP", "MRO", "MUR", "MVR", "MWK", "MXN", "MXV", "MYR", "MZN",
-	"NAD", "NGN", "NIO", "NOK", "NPR", "NZD",
-	"OMR",
-	"PAB", "PEN", "PGK", "PHP", "PKR", "PLN", "PYG",
-	"QAR",
-	"RON", "RSD", "RUB", "RWF",
-	"SAR", "SBD", "SCR", "SDG", "SEK", "SGD", "SHP", "SLL", "SOS", "SRD", "SSP", "STD", "SVC", "SYP", "SZL",
-	"THB", "TJS", "TMT", "TND", "TOP", "TRY", "TTD", "TWD", "TZS",
-	"UAH", "UGX", "USD", "USN", "UYI", "UYU", "UZS",
-	"VEF", "VND", "VUV",
-	"WST",
-	"XAF", "XAG", "XAU", "XBA", "XBB", "XBC", "XBD", "XCD", "XDR", "XOF", "XPD", "XPF", "XPT", "XSU", "XTS", "XUA", "XXX",
-	"YER",
-	"ZAR", "ZMW", "ZWL",
+var ISO4217List = func() []string {
+	codes := make([]string, 0, len(ISO4217Currencies))
+	for _, currency := range ISO4217Currencies {
+		codes = append(codes, currency.Code)
+	}
+	return codes
+}()
+
+// Country is the ISO 3166-1 country code in alpha-2, alpha-3 and numeric.
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string
+}
+
+// ISO3166Countries is the list of the ISO 3166-1 countries
+var ISO3166Countries = []Country{
+	{"AF", "AFG", "004"}, {"AX", "ALA", "248"}, {"AL", "ALB", "008"}, {"DZ", "DZA", "012"},
+	{"AS", "ASM", "016"}, {"AD", "AND", "020"}, {"AO", "AGO", "024"}, {"AI", "AIA", "660"},
+	{"AQ", "ATA", "010"}, {"AG", "ATG", "028"}, {"AR", "ARG", "032"}, {"AM", "ARM", "051"},
+	{"AW", "ABW", "533"}, {"AU", "AUS", "036"}, {"AT", "AUT", "040"}, {"AZ", "AZE", "031"},
+	{"BS", "BHS", "044"}, {"BH", "BHR", "048"}, {"BD", "BGD", "050"}, {"BB", "BRB", "052"},
+	{"BY", "BLR", "112"}, {"BE", "BEL", "056"}, {"BZ", "BLZ", "084"}, {"BJ", "BEN", "204"},
+	{"BM", "BMU", "060"}, {"BT", "BTN", "064"}, {"BO", "BOL", "068"}, {"BQ", "BES", "535"},
+	{"BA", "BIH", "070"}, {"BW", "BWA", "072"}, {"BV", "BVT", "074"}, {"BR", "BRA", "076"},
+	{"IO", "IOT", "086"}, {"BN", "BRN", "096"}, {"BG", "BGR", "100"}, {"BF", "BFA", "854"},
+	{"BI", "BDI", "108"}, {"CV", "CPV", "132"}, {"KH", "KHM", "116"}, {"CM", "CMR", "120"},
+	{"CA", "CAN", "124"}, {"KY", "CYM", "136"}, {"CF", "CAF", "140"}, {"TD", "TCD", "148"},
+	{"CL", "CHL", "152"}, {"CN", "CHN", "156"}, {"CX", "CXR", "162"}, {"CC", "CCK", "166"},
+	{"CO", "COL", "170"}, {"KM", "COM", "174"}, {"CG", "COG", "178"}, {"CD", "COD", "180"},
+	{"CK", "COK", "184"}, {"CR", "CRI", "188"}, {"CI", "CIV", "384"}, {"HR", "HRV", "191"},
+	{"CU", "CUB", "192"}, {"CW", "CUW", "531"}, {"CY", "CYP", "196"}, {"CZ", "CZE", "203"},
+	{"DK", "DNK", "208"}, {"DJ", "DJI", "262"}, {"DM", "DMA", "212"}, {"DO", "DOM", "214"},
+	{"EC", "ECU", "218"}, {"EG", "EGY", "818"}, {"SV", "SLV", "222"}, {"GQ", "GNQ", "226"},
+	{"ER", "ERI", "232"}, {"EE", "EST", "233"}, {"SZ", "SWZ", "748"}, {"ET", "ETH", "231"},
+	{"FK", "FLK", "238"}, {"FO", "FRO", "234"}, {"FJ", "FJI", "242"}, {"FI", "FIN", "246"},
+	{"FR", "FRA", "250"}, {"GF", "GUF", "254"}, {"PF", "PYF", "258"}, {"TF", "ATF", "260"},
+	{"GA", "GAB", "266"}, {"GM", "GMB", "270"}, {"GE", "GEO", "268"}, {"DE", "DEU", "276"},
+	{"GH", "GHA", "288"}, {"GI", "GIB", "292"}, {"GR", "GRC", "300"}, {"GL", "GRL", "304"},
+	{"GD", "GRD", "308"}, {"GP", "GLP", "312"}, {"GU", "GUM", "316"}, {"GT", "GTM", "320"},
+	{"GG", "GGY", "831"}, {"GN", "GIN", "324"}, {"GW", "GNB", "624"}, {"GY", "GUY", "328"},
+	{"HT", "HTI", "332"}, {"HM", "HMD", "334"}, {"VA", "VAT", "336"}, {"HN", "HND", "340"},
+	{"HK", "HKG", "344"}, {"HU", "HUN", "348"}, {"IS", "ISL", "352"}, {"IN", "IND", "356"},
+	{"ID", "IDN", "360"}, {"IR", "IRN", "364"}, {"IQ", "IRQ", "368"}, {"IE", "IRL", "372"},
+	{"IM", "IMN", "833"}, {"IL", "ISR", "376"}, {"IT", "ITA", "380"}, {"JM", "JAM", "388"},
+	{"JP", "JPN", "392"}, {"JE", "JEY", "832"}, {"JO", "JOR", "400"}, {"KZ", "KAZ", "398"},
+	{"KE", "KEN", "404"}, {"KI", "KIR", "296"}, {"KP", "PRK", "408"}, {"KR", "KOR", "410"},
+	{"KW", "KWT", "414"}, {"KG", "KGZ", "417"}, {"LA", "LAO", "418"}, {"LV", "LVA", "428"},
+	{"LB", "LBN", "422"}, {"LS", "LSO", "426"}, {"LR", "LBR", "430"}, {"LY", "LBY", "434"},
+	{"LI", "LIE", "438"}, {"LT", "LTU", "440"}, {"LU", "LUX", "442"}, {"MO", "MAC", "446"},
+	{"MG", "MDG", "450"}, {"MW", "MWI", "454"}, {"MY", "MYS", "458"}, {"MV", "MDV", "462"},
+	{"ML", "MLI", "466"}, {"MT", "MLT", "470"}, {"MH", "MHL", "584"}, {"MQ", "MTQ", "474"},
+	{"MR", "MRT", "478"}, {"MU", "MUS", "480"}, {"YT", "MYT", "175"}, {"MX", "MEX", "484"},
+	{"FM", "FSM", "583"}, {"MD", "MDA", "498"}, {"MC", "MCO", "492"}, {"MN", "MNG", "496"},
+	{"ME", "MNE", "499"}, {"MS", "MSR", "500"}, {"MA", "MAR", "504"}, {"MZ", "MOZ", "508"},
+	{"MM", "MMR", "104"}, {"NA", "NAM", "516"}, {"NR", "NRU", "520"}, {"NP", "NPL", "524"},
+	{"NL", "NLD", "528"}, {"NC", "NCL", "540"}, {"NZ", "NZL", "554"}, {"NI", "NIC", "558"},
+	{"NE", "NER", "562"}, {"NG", "NGA", "566"}, {"NU", "NIU", "570"}, {"NF", "NFK", "574"},
+	{"MK", "MKD", "807"}, {"MP", "MNP", "580"}, {"NO", "NOR", "578"}, {"OM", "OMN", "512"},
+	{"PK", "PAK", "586"}, {"PW", "PLW", "585"}, {"PS", "PSE", "275"}, {"PA", "PAN", "591"},
+	{"PG", "PNG", "598"}, {"PY", "PRY", "600"}, {"PE", "PER", "604"}, {"PH", "PHL", "608"},
+	{"PN", "PCN", "612"}, {"PL", "POL", "616"}, {"PT", "PRT", "620"}, {"PR", "PRI", "630"},
+	{"QA", "QAT", "634"}, {"RE", "REU", "638"}, {"RO", "ROU", "642"}, {"RU", "RUS", "643"},
+	{"RW", "RWA", "646"}, {"BL", "BLM", "652"}, {"SH", "SHN", "654"}, {"KN", "KNA", "659"},
+	{"LC", "LCA", "662"}, {"MF", "MAF", "663"}, {"PM", "SPM", "666"}, {"VC", "VCT", "670"},
+	{"WS", "WSM", "882"}, {"SM", "SMR", "674"}, {"ST", "STP", "678"}, {"SA", "SAU", "682"},
+	{"SN", "SEN", "686"}, {"RS", "SRB", "688"}, {"SC", "SYC", "690"}, {"SL", "SLE", "694"},
+	{"SG", "SGP", "702"}, {"SX", "SXM", "534"}, {"SK", "SVK", "703"}, {"SI", "SVN", "705"},
+	{"SB", "SLB", "090"}, {"SO", "SOM", "706"}, {"ZA", "ZAF", "710"}, {"GS", "SGS", "239"},
+	{"SS", "SSD", "728"}, {"ES", "ESP", "724"}, {"LK", "LKA", "144"}, {"SD", "SDN", "729"},
+	{"SR", "SUR", "740"}, {"SJ", "SJM", "744"}, {"SE", "SWE", "752"}, {"CH", "CHE", "756"},
+	{"SY", "SYR", "760"}, {"TW", "TWN", "158"}, {"TJ", "TJK", "762"}, {"TZ", "TZA", "834"},
+	{"TH", "THA", "764"}, {"TL", "TLS", "626"}, {"TG", "TGO", "768"}, {"TK", "TKL", "772"},
+	{"TO", "TON", "776"}, {"TT", "TTO", "780"}, {"TN", "TUN", "788"}, {"TR", "TUR", "792"},
+	{"TM", "TKM", "795"}, {"TC", "TCA", "796"}, {"TV", "TUV", "798"}, {"UG", "UGA", "800"},
+	{"UA", "UKR", "804"}, {"AE", "ARE", "784"}, {"GB", "GBR", "826"}, {"US", "USA", "840"},
+	{"UM", "UMI", "581"}, {"UY", "URY", "858"}, {"UZ", "UZB", "860"}, {"VU", "VUT", "548"},
+	{"VE", "VEN", "862"}, {"VN", "VNM", "704"}, {"VG", "VGB", "092"}, {"VI", "VIR", "850"},
+	{"WF", "WLF", "876"}, {"EH", "ESH", "732"}, {"YE", "YEM", "887"}, {"ZM", "ZMB", "894"},
+	{"ZW", "ZWE", "716"},
+}
+
+// ISO639Alpha2List is the list of the ISO 639-1 language codes
+var ISO639Alpha2List = []string{
+	"aa", "ab", "ae", "af", "ak", "am", "an", "ar", "as", "av", "ay", "az", "ba", "be", "bg", "bi",
+	"bm", "bn", "bo", "br", "bs", "ca", "ce", "ch", "co", "cr", "cs", "cu", "cv", "cy", "da", "de",
+	"dv", "dz", "ee", "el", "en", "eo", "es", "et", "eu", "fa", "ff", "fi", "fj", "fo", "fr", "fy",
+	"ga", "gd", "gl", "gn", "gu", "gv", "ha", "he", "hi", "ho", "hr", "ht", "hu", "hy", "hz", "ia",
+	"id", "ie", "ig", "ii", "ik", "io", "is", "it", "iu", "ja", "jv", "ka", "kg", "ki", "kj", "kk",
+	"kl", "km", "kn", "ko", "kr", "ks", "ku", "kv", "kw", "ky", "la", "lb", "lg", "li", "ln", "lo",
+	"lt", "lu", "lv", "mg", "mh", "mi", "mk", "ml", "mn", "mr", "ms", "mt", "my", "na", "nb", "nd",
+	"ne", "ng", "nl", "nn", "no", "nr", "nv", "ny", "oc", "oj", "om", "or", "os", "pa", "pi", "pl",
+	"ps", "pt", "qu", "rm", "rn", "ro", "ru", "rw", "sa", "sc", "sd", "se", "sg", "si", "sk", "sl",
+	"sm", "sn", "so", "sq", "sr", "ss", "st", "su", "sv", "sw", "ta", "te", "tg", "th", "ti", "tk",
+	"tl", "tn", "to", "tr", "ts", "tt", "tw", "ty", "ug", "uk", "ur", "uz", "ve", "vi", "vo", "wa",
+	"wo", "xh", "yi", "yo", "za", "zh", "zu",
+}
+
+// ISO639Alpha3List is the list of the ISO 639-2 language codes, including both the bibliographic
+// and terminologic codes
+var ISO639Alpha3List = []string{
+	"aar", "abk", "ace", "ach", "ada", "ady", "afa", "afh", "afr", "ain", "aka", "akk", "alb", "sqi",
+	"ale", "alg", "alt", "amh", "ang", "anp", "apa", "ara", "arc", "arg", "arm", "hye", "arn", "arp",
+	"art", "arw", "asm", "ast", "ath", "aus", "ava", "ave", "awa", "aym", "aze", "bad", "bai", "bak",
+	"bal", "bam", "ban", "baq", "eus", "bas", "bat", "bej", "bel", "bem", "ben", "ber", "bho", "bih",
+	"bik", "bin", "bis", "bla", "bnt", "tib", "bod", "bos", "bra", "bre", "btk", "bua", "bug", "bul",
+	"bur", "mya", "byn", "cad", "cai", "car", "cat", "cau", "ceb", "cel", "cze", "ces", "cha", "chb",
+	"che", "chg", "chi", "zho", "chk", "chm", "chn", "cho", "chp", "chr", "chu", "chv", "chy", "cmc",
+	"cnr", "cop", "cor", "cos", "cpe", "cpf", "cpp", "cre", "crh", "crp", "csb", "cus", "wel", "cym",
+	"dak", "dan", "dar", "day", "del", "den", "ger", "deu", "dgr", "din", "div", "doi", "dra", "dsb",
+	"dua", "dum", "dut", "nld", "dyu", "dzo", "efi", "egy", "eka", "gre", "ell", "elx", "eng", "enm",
+	"epo", "est", "ewe", "ewo", "fan", "fao", "per", "fas", "fat", "fij", "fil", "fin", "fiu", "fon",
+	"fre", "fra", "frm", "fro", "frr", "frs", "fry", "ful", "fur", "gaa", "gay", "gba", "gem", "geo",
+	"kat", "gez", "gil", "gla", "gle", "glg", "glv", "gmh", "goh", "gon", "gor", "got", "grb", "grc",
+	"grn", "gsw", "guj", "gwi", "hai", "hat", "hau", "haw", "heb", "her", "hil", "him", "hin", "hit",
+	"hmn", "hmo", "hrv", "hsb", "hun", "hup", "iba", "ibo", "ice", "isl", "ido", "iii", "ijo", "iku",
+	"ile", "ilo", "ina", "inc", "ind", "ine", "inh", "ipk", "ira", "iro", "ita", "jav", "jbo", "jpn",
+	"jpr", "jrb", "kaa", "kab", "kac", "kal", "kam", "kan", "kar", "kas", "kau", "kaw", "kaz", "kbd",
+	"kha", "khi", "khm", "kho", "kik", "kin", "kir", "kmb", "kok", "kom", "kon", "kor", "kos", "kpe",
+	"krc", "krl", "kro", "kru", "kua", "kum", "kur", "kut", "lad", "lah", "lam", "lao", "lat", "lav",
+	"lez", "lim", "lin", "lit", "lol", "loz", "ltz", "lua", "lub", "lug", "lui", "lun", "luo", "lus",
+	"mac", "mkd", "mad", "mag", "mah", "mai", "mak", "mal", "man", "mao", "mri", "map", "mar", "mas",
+	"may", "msa", "mdf", "mdr", "men", "mga", "mic", "min", "mis", "mkh", "mlg", "mlt", "mnc", "mni",
+	"mno", "moh", "mon", "mos", "mul", "mun", "mus", "mwl", "mwr", "myn", "myv", "nah", "nai", "nap",
+	"nau", "nav", "nbl", "nde", "ndo", "nds", "nep", "new", "nia", "nic", "niu", "nno", "nob", "nog",
+	"non", "nor", "nqo", "nso", "nub", "nwc", "nya", "nym", "nyn", "nyo", "nzi", "oci", "oji", "ori",
+	"orm", "osa", "oss", "ota", "oto", "paa", "pag", "pal", "pam", "pan", "pap", "pau", "peo", "phi",
+	"phn", "pli", "pol", "pon", "por", "pra", "pro", "pus", "que", "raj", "rap", "rar", "roa", "roh",
+	"rom", "rum", "ron", "run", "rup", "rus", "sad", "sag", "sah", "sai", "sal", "sam", "san", "sas",
+	"sat", "scn", "sco", "sel", "sem", "sga", "sgn", "shn", "sid", "sin", "sio", "sit", "sla", "slo",
+	"slk", "slv", "sma", "sme", "smi", "smj", "smn", "smo", "sms", "sna", "snd", "snk", "sog", "som",
+	"son", "sot", "spa", "srd", "srn", "srp", "srr", "ssa", "ssw", "suk", "sun", "sus", "sux", "swa",
+	"swe", "syc", "syr", "tah", "tai", "tam", "tat", "tel", "tem", "ter", "tet", "tgk", "tgl", "tha",
+	"tig", "tir", "tiv", "tkl", "tlh", "tli", "tmh", "tog", "ton", "tpi", "tsi", "tsn", "tso", "tuk",
+	"tum", "tup", "tur", "tut", "tvl", "twi", "tyv", "udm", "uga", "uig", "ukr", "umb", "und", "urd",
+	"uzb", "vai", "ven", "vie", "vol", "vot", "wak", "wal", "war", "was", "wen", "wln", "wol", "xal",
+	"xho", "yao", "yap", "yid", "yor", "ypk", "zap", "zbl", "zen", "zgh", "zha", "znd", "zul", "zun",
+	"zxx", "zza",
 }
@@ -0,0 +1,198 @@
+package govalidator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/mail"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	maxEmailLength       = 254
+	maxEmailLocalLength  = 64
+	maxEmailDomainLength = 255
+)
+
+var (
+	ErrEmailTooLong       = errors.New("email too long")
+	ErrEmailLocalTooLong  = errors.New("email local part too long")
+	ErrEmailDomainTooLong = errors.New("email domain too long")
+	ErrDisposableEmail    = errors.New("disposable email")
+)
+
+func ErrUnknownEmailOption(option string) error {
+	return fmt.Errorf("unknown email option: %v", option)
+}
+
+// rxHTML5Email is the valid email address of the WHATWG HTML5 input element.
+var rxHTML5Email = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+
+// Email validation modes.
+const (
+	EmailModeDefault = ""
+	EmailModeHTML5   = "html5"
+	EmailModeRFC5322 = "rfc5322"
+	EmailModeStrict  = "strict"
+)
+
+// DisposableDomains is the blocklist of the disposable email domains.
+var DisposableDomains = &domainSet{}
+
+type domainSet struct {
+	store sync.Map
+}
+
+// Add adds the domains to the set.
+func (s *domainSet) Add(domains ...string) {
+	for _, domain := range domains {
+		s.store.Store(strings.ToLower(strings.TrimSuffix(domain, ".")), struct{}{})
+	}
+}
+
+// Contains reports whether the domain or any of its parent domains is in the set.
+func (s *domainSet) Contains(domain string) bool {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	for {
+		if _, ok := s.store.Load(domain); ok {
+			return true
+		}
+		p := strings.IndexByte(domain, '.')
+		if p == -1 {
+			return false
+		}
+		domain = domain[p+1:]
+	}
+}
+
+// Load adds the domains in the file, one per line, the empty lines and `#` comments are ignored.
+func (s *domainSet) Load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if p := strings.IndexByte(line, '#'); p != -1 {
+			line = line[:p]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			s.Add(line)
+		}
+	}
+	return scanner.Err()
+}
+
+// EmailValidator validates the email address by Mode, one of html5, rfc5322 and strict,
+// the default mode uses the same pattern as IsEmail.
+// DisplayName accepts the `"Name" <a@b.c>` form, IDN accepts the internationalized domain names,
+// NoDisposable rejects the domains in DisposableDomains.
+type EmailValidator struct {
+	Mode         string
+	DisplayName  bool
+	IDN          bool
+	NoDisposable bool
+}
+
+// NewEmailValidator creates the email validator from the options:
+// html5, rfc5322, strict, display, idn, nodisposable.
+func NewEmailValidator(options ...string) *EmailValidator {
+	v := &EmailValidator{}
+	for _, option := range options {
+		switch option {
+		case EmailModeHTML5, EmailModeRFC5322, EmailModeStrict:
+			v.Mode = option
+		case "display":
+			v.DisplayName = true
+		case "idn":
+			v.IDN = true
+		case "nodisposable":
+			v.NoDisposable = true
+		default:
+			panic(ErrUnknownEmailOption(option))
+		}
+	}
+	return v
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *EmailValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if v.DisplayName {
+		addr, err := mail.ParseAddress(str)
+		if err != nil {
+			return ErrInvalidEmail
+		}
+		str = addr.Address
+	}
+
+	p := strings.LastIndexByte(str, '@')
+	if p == -1 {
+		return ErrInvalidEmail
+	}
+	local, domain := str[:p], str[p+1:]
+
+	if v.IDN && !isASCII(domain) {
+		ascii, ok := toASCIIDomain(domain)
+		if !ok {
+			return ErrInvalidEmail
+		}
+		domain = ascii
+		str = local + "@" + domain
+	}
+
+	switch {
+	case len(str) > maxEmailLength:
+		return ErrEmailTooLong
+	case len(local) > maxEmailLocalLength:
+		return ErrEmailLocalTooLong
+	case len(domain) > maxEmailDomainLength:
+		return ErrEmailDomainTooLong
+	}
+
+	if !v.match(str, local, domain) {
+		return ErrInvalidEmail
+	}
+
+	if v.NoDisposable && DisposableDomains.Contains(domain) {
+		return ErrDisposableEmail
+	}
+	return nil
+}
+
+func (v *EmailValidator) match(str, local, domain string) bool {
+	switch v.Mode {
+	case EmailModeHTML5:
+		return rxHTML5Email.MatchString(str)
+	case EmailModeRFC5322:
+		addr, err := mail.ParseAddress(str)
+		return err == nil && addr.Name == "" && !strings.ContainsAny(str, "<>")
+	case EmailModeStrict:
+		return userRegexp.MatchString(local) && !userDotRegexp.MatchString(local) &&
+			hostRegexp.MatchString(domain) && isFQDN(domain) && isPunycodeValid(domain)
+	}
+	return rxEmail.MatchString(str)
+}
+
+// CompileEmail creates the validator of tag `email(...)`, see NewEmailValidator for the options.
+// Without options, the tag is validated by IsEmail.
+func CompileEmail(args ...string) Validator {
+	if len(args) == 0 {
+		return ValidateFunc(IsEmail)
+	}
+	return NewEmailValidator(args...)
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("email", CompileEmail)
+}
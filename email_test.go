@@ -0,0 +1,100 @@
+package govalidator
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailValidator(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		options  []string
+		param    string
+		expected bool
+	}{
+		{nil, "", true},
+		{nil, "foo@bar.com", true},
+		{nil, "foo@bar", false},
+		{[]string{"html5"}, "foo@bar.com", true},
+		{[]string{"html5"}, "foo@bar", true},
+		{[]string{"html5"}, "foo.bar+tag@sub.bar.com", true},
+		{[]string{"html5"}, `"foo bar"@bar.com`, false},
+		{[]string{"html5"}, "foo@-bar.com", false},
+		{[]string{"html5"}, "foo@bücher.de", false},
+		{[]string{"html5", "idn"}, "foo@bücher.de", true},
+		{[]string{"rfc5322"}, "foo@bar.com", true},
+		{[]string{"rfc5322"}, `"foo bar"@bar.com`, true},
+		{[]string{"rfc5322"}, "foo@localhost", true},
+		{[]string{"rfc5322"}, "foo..bar@bar.com", false},
+		{[]string{"rfc5322"}, "Foo <foo@bar.com>", false},
+		{[]string{"rfc5322"}, "foo@", false},
+		{[]string{"strict"}, "foo@bar.com", true},
+		{[]string{"strict"}, "foo.bar@bar.co.uk", true},
+		{[]string{"strict"}, "foo@localhost", false},
+		{[]string{"strict"}, ".foo@bar.com", false},
+		{[]string{"strict"}, "foo.@bar.com", false},
+		{[]string{"strict"}, "foo..bar@bar.com", false},
+		{[]string{"strict"}, "foo@bar_baz.com", false},
+		{[]string{"strict"}, "foo@xn--a!.com", false},
+		{[]string{"strict", "idn"}, "foo@例え.jp", true},
+		{[]string{"strict", "display"}, `"Foo Bar" <foo@bar.com>`, true},
+		{[]string{"strict", "display"}, "Foo <foo@bar.com>", true},
+		{[]string{"strict", "display"}, "foo@bar.com", true},
+		{[]string{"strict", "display"}, "Foo <foo@bar>", false},
+		{[]string{"strict", "display"}, "Foo <foo@bar.com", false},
+		{[]string{"strict"}, strings.Repeat("a", 65) + "@bar.com", false},
+		{[]string{"strict"}, strings.Repeat("a", 64) + "@bar.com", true},
+		{[]string{"strict"}, "a@" + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 63) + "." + strings.Repeat("e", 61), false},
+	}
+	for _, test := range tests {
+		err := NewEmailValidator(test.options...).Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check email(%v) %s", test.options, test.param)
+		} else {
+			require.Error(t, err, "check email(%v) %s", test.options, test.param)
+		}
+	}
+
+	require.Equal(t, ErrEmailLocalTooLong, NewEmailValidator().Validate(strings.Repeat("a", 65)+"@bar.com"))
+	require.Panics(t, func() { NewEmailValidator("unknown") })
+}
+
+func TestDisposableDomains(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disposable.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# disposable domains\nmailinator.com\n\n  Trash-Mail.com  # comment\n"), 0644))
+
+	domains := &domainSet{}
+	require.NoError(t, domains.Load(path))
+	require.True(t, domains.Contains("mailinator.com"))
+	require.True(t, domains.Contains("sub.mailinator.com"))
+	require.True(t, domains.Contains("trash-mail.com"))
+	require.False(t, domains.Contains("gmail.com"))
+	require.Error(t, domains.Load(filepath.Join(t.TempDir(), "missing.txt")))
+
+	DisposableDomains.Add("test-disposable.example")
+	v := NewEmailValidator("strict", "nodisposable")
+	require.NoError(t, v.Validate("foo@bar.com"))
+	require.True(t, errors.Is(v.Validate("foo@Test-Disposable.example"), ErrDisposableEmail))
+}
+
+type stEmail struct {
+	Email   string `json:"email" valid:"email"`
+	Contact string `json:"contact" valid:"email(strict,display)"`
+}
+
+func TestEmailTags(t *testing.T) {
+	require.Contains(t, TagMap, "email")
+	require.IsType(t, CompileFunc(nil), TagValidatorMap.Get("email"))
+
+	require.NoError(t, ValidateStruct(&stEmail{Email: "foo@bar.com", Contact: "Foo <foo@bar.com>"}))
+
+	err := ValidateStruct(&stEmail{Email: "foo", Contact: "foo@localhost"})
+	require.Error(t, err)
+	require.Equal(t, []string{"email", "contact"}, err.(Errors).Fields())
+}
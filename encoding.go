@@ -0,0 +1,317 @@
+package govalidator
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrInvalidBase64URL = errors.New("invalid base64url")
+	ErrInvalidHex       = errors.New("invalid hex")
+	ErrInvalidJWT       = errors.New("invalid jwt")
+	ErrInvalidPEM       = errors.New("invalid pem")
+	ErrInvalidX509      = errors.New("invalid x509 certificate")
+	ErrInvalidSemver    = errors.New("invalid semver")
+	ErrCertExpired      = errors.New("certificate expired")
+	ErrCertNotYetValid  = errors.New("certificate not yet valid")
+	ErrNotStringOrBytes = errors.New("not string or bytes")
+)
+
+// JSONTypes are the JSON value types of tag `json(object|array)`.
+var JSONTypes = []string{"object", "array", "string", "number", "boolean", "null"}
+
+func ErrJSONType(got string, expected ...string) error {
+	return fmt.Errorf("%w: type should be one of [%v], but got %v", ErrInvalidJSON, strings.Join(expected, ","), got)
+}
+
+func ErrUnknownJSONType(typ string) error {
+	return fmt.Errorf("unknown json type: %v", typ)
+}
+
+func ErrPEMType(got string, expected ...string) error {
+	return fmt.Errorf("%w: block type should be one of [%v], but got %v", ErrInvalidPEM, strings.Join(expected, ","), got)
+}
+
+// rxSemver is the regular expression suggested by https://semver.org.
+var rxSemver = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// jsonType returns the type of the valid JSON value, one of JSONTypes.
+func jsonType(data []byte) string {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	}
+	return "number"
+}
+
+// JSONValidator checks the string is valid JSON, whose type is one of Types if not empty.
+type JSONValidator struct {
+	Types []string
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *JSONValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	data := []byte(str)
+	if !json.Valid(data) {
+		return ErrInvalidJSON
+	}
+	if len(v.Types) > 0 {
+		if typ := jsonType(data); !containsString(v.Types, typ) {
+			return ErrJSONType(typ, v.Types...)
+		}
+	}
+	return nil
+}
+
+// CompileJSON creates the validator of tag `json` or `json(object|array)`, see JSONTypes.
+func CompileJSON(args ...string) Validator {
+	var types []string
+	for _, arg := range args {
+		for _, typ := range strings.Split(arg, "|") {
+			typ = strings.TrimSpace(typ)
+			if !containsString(JSONTypes, typ) {
+				panic(ErrUnknownJSONType(typ))
+			}
+			types = append(types, typ)
+		}
+	}
+	return &JSONValidator{Types: types}
+}
+
+// IsBase64URL check if the string is base64url encoded, with or without padding. Empty string is valid.
+func IsBase64URL(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	encoding := base64.RawURLEncoding
+	if strings.HasSuffix(str, "=") {
+		encoding = base64.URLEncoding
+	}
+	if _, err := encoding.Strict().DecodeString(str); err != nil {
+		return ErrInvalidBase64URL
+	}
+	return nil
+}
+
+// IsBase64Raw check if the string is standard base64 encoded without padding. Empty string is valid.
+func IsBase64Raw(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if _, err := base64.RawStdEncoding.Strict().DecodeString(str); err != nil {
+		return ErrInvalidBase64
+	}
+	return nil
+}
+
+// IsHex check if the string is hex encoded bytes of even length. Empty string is valid.
+func IsHex(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if _, err := hex.DecodeString(str); err != nil {
+		return ErrInvalidHex
+	}
+	return nil
+}
+
+// IsJWT check if the string is a JWT of three base64url segments, whose header is a JSON object
+// with the `alg`. The signature is not verified. Empty string is valid.
+func IsJWT(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	segments := strings.Split(str, ".")
+	if len(segments) != 3 {
+		return ErrInvalidJWT
+	}
+	for _, segment := range segments {
+		if _, err := base64.RawURLEncoding.Strict().DecodeString(segment); err != nil {
+			return ErrInvalidJWT
+		}
+	}
+
+	data, _ := base64.RawURLEncoding.DecodeString(segments[0])
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil || header.Alg == "" {
+		return ErrInvalidJWT
+	}
+	return nil
+}
+
+// IsSemver check if the string is a semantic version 2.0.0 like 1.2.3-rc.1+build.5, the `v`
+// prefix is not allowed. Empty string is valid.
+func IsSemver(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxSemver.MatchString(str) {
+		return nil
+	}
+	return ErrInvalidSemver
+}
+
+// assertBytes returns the bytes of the string or []byte kinds, including the named types like
+// json.RawMessage, panics for other kinds.
+func assertBytes(value interface{}) []byte {
+	val := reflect.ValueOf(value)
+	switch {
+	case val.Kind() == reflect.String:
+		return []byte(val.String())
+	case val.Kind() == reflect.Slice && val.Type().Elem().Kind() == reflect.Uint8:
+		return val.Bytes()
+	}
+	panic(ErrNotStringOrBytes)
+}
+
+// decodePEM decodes all PEM blocks of the data, only whitespaces are allowed around the blocks.
+func decodePEM(data []byte) ([]*pem.Block, error) {
+	var blocks []*pem.Block
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		data = rest
+	}
+	if len(blocks) == 0 || len(bytes.TrimSpace(data)) > 0 {
+		return nil, ErrInvalidPEM
+	}
+	return blocks, nil
+}
+
+// PEMValidator checks the value is PEM encoded blocks of Types.
+type PEMValidator struct {
+	Types []string
+}
+
+// Validate implements the Validator interface, the value can be string or []byte. Empty value is valid.
+func (v *PEMValidator) Validate(value interface{}, args ...string) error {
+	data := assertBytes(value)
+	if len(data) == 0 {
+		return nil
+	}
+
+	blocks, err := decodePEM(data)
+	if err != nil {
+		return err
+	}
+	if len(v.Types) > 0 {
+		for _, block := range blocks {
+			if !containsString(v.Types, block.Type) {
+				return ErrPEMType(block.Type, v.Types...)
+			}
+		}
+	}
+	return nil
+}
+
+// CompilePEM creates the validator of tag `pem` or `pem(CERTIFICATE|PUBLIC KEY)`.
+func CompilePEM(args ...string) Validator {
+	var types []string
+	for _, arg := range args {
+		for _, typ := range strings.Split(arg, "|") {
+			types = append(types, strings.TrimSpace(typ))
+		}
+	}
+	return &PEMValidator{Types: types}
+}
+
+// parseCertificates parses the PEM encoded CERTIFICATE blocks, or the DER encoded certificates.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN")) {
+		return x509.ParseCertificates(data)
+	}
+
+	blocks, err := decodePEM(data)
+	if err != nil {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for _, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			return nil, ErrPEMType(block.Type, "CERTIFICATE")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// IsX509 check if the value is PEM or DER encoded x509 certificates, or *x509.Certificate,
+// which are valid at govalidator.Now. Empty value is valid.
+func IsX509(value interface{}, args ...string) error {
+	var certs []*x509.Certificate
+	if cert, ok := value.(*x509.Certificate); ok {
+		if cert == nil {
+			return nil
+		}
+		certs = []*x509.Certificate{cert}
+	} else {
+		data := assertBytes(value)
+		if len(data) == 0 {
+			return nil
+		}
+		parsed, err := parseCertificates(data)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidX509, err)
+		}
+		if len(parsed) == 0 {
+			return ErrInvalidX509
+		}
+		certs = parsed
+	}
+
+	now := Now()
+	for _, cert := range certs {
+		if now.Before(cert.NotBefore) {
+			return checkError(ErrInvalidX509, ErrCertNotYetValid)
+		}
+		if now.After(cert.NotAfter) {
+			return checkError(ErrInvalidX509, ErrCertExpired)
+		}
+	}
+	return nil
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("json", CompileJSON)
+	TagValidatorMap.RegisterCompileFunc("pem", CompilePEM)
+}
@@ -1,6 +1,7 @@
 package govalidator
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -8,25 +9,35 @@ import (
 )
 
 // Errors is an array of multiple errors and conforms to the error interface.
+// The errors are ordered by the struct field declaration, slice index and sorted map key.
 type Errors []*Error
 
+// Append appends err located under the field path, see AppendPath.
 func (es *Errors) Append(err error, path string) {
-	switch v := err.(type) {
+	es.AppendPath(err, PathField(path))
+}
+
+// AppendPath appends err located under path, the errors are copied instead of modified
+// and duplicated errors are dropped.
+func (es *Errors) AppendPath(err error, path ...Segment) {
+	switch v := withPath(err, path...).(type) {
 	case Errors:
 		for _, e := range v {
-			e.Name = path + "." + e.Name
+			es.add(e)
 		}
-		*es = append(*es, v...)
 	case *Error:
-		v.Name = path + "." + v.Name
-		*es = append(*es, v)
-	default:
-		e := &Error{
-			Name: path,
-			Err:  v,
+		es.add(v)
+	}
+}
+
+func (es *Errors) add(err *Error) {
+	field := err.Field()
+	for _, e := range *es {
+		if e.Code == err.Code && e.Field() == field && e.Message() == err.Message() {
+			return
 		}
-		*es = append(*es, e)
 	}
+	*es = append(*es, err)
 }
 
 func (es Errors) Error() string {
@@ -41,26 +52,190 @@ func (es *Errors) Empty() bool {
 	return len(*es) == 0
 }
 
+// FindByName returns the first error of the field path.
 func (es *Errors) FindByName(name string) *Error {
 	for _, err := range *es {
-		if err.Name == name {
+		if err.Field() == name {
 			return err
 		}
 	}
 	return nil
 }
 
-// Error encapsulates a name, an error and whether there's a custom error message or not.
+// ByField returns all the errors of the field path.
+func (es Errors) ByField(path string) Errors {
+	var result Errors
+	for _, e := range es {
+		if e.Field() == path {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// ByPrefix returns all the errors of the field path and its nested fields,
+// e.g. prefix `user` matches `user`, `user.name` and `user[0]`, but not `username`.
+func (es Errors) ByPrefix(prefix string) Errors {
+	var result Errors
+	for _, e := range es {
+		if hasPathPrefix(e.Field(), prefix) {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func hasPathPrefix(path, prefix string) bool {
+	if prefix == "" || path == prefix {
+		return true
+	}
+	if !strings.HasPrefix(path, prefix) {
+		return false
+	}
+	next := path[len(prefix)]
+	return next == '.' || next == '['
+}
+
+// HasField reports whether the field path has any error.
+func (es Errors) HasField(path string) bool {
+	for _, e := range es {
+		if e.Field() == path {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCode reports whether any error has the code.
+func (es Errors) HasCode(code string) bool {
+	for _, e := range es {
+		if e.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Fields returns the failing field paths in order, without duplicates.
+func (es Errors) Fields() []string {
+	fields := make([]string, 0, len(es))
+	seen := make(map[string]struct{}, len(es))
+	for _, e := range es {
+		field := e.Field()
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// Map returns the error messages grouped by field path, suitable for API responses.
+func (es Errors) Map() map[string][]string {
+	result := make(map[string][]string, len(es))
+	for _, e := range es {
+		field := e.Field()
+		result[field] = append(result[field], e.Message())
+	}
+	return result
+}
+
+// Unwrap returns the errors, so errors.Is and errors.As can match any of them.
+func (es Errors) Unwrap() []error {
+	errs := make([]error, len(es))
+	for i, e := range es {
+		errs[i] = e
+	}
+	return errs
+}
+
+// MarshalJSON implements the json.Marshaler interface, the errors are encoded as an array.
+func (es Errors) MarshalJSON() ([]byte, error) {
+	items := make([]jsonError, 0, len(es))
+	for _, e := range es {
+		items = append(items, e.toJSON())
+	}
+	return json.Marshal(items)
+}
+
+// Error encapsulates a path, an error and whether there's a custom error message or not.
+// Code is the name of the tag validator which reports the error and Params are its arguments,
+// both are empty for the other errors.
 type Error struct {
-	Name string
-	Err  error
+	// Deprecated: Name is the rendered Path kept for compatibility, use Field instead.
+	Name   string
+	Path   Path
+	Code   string
+	Params []string
+	Err    error
 }
 
 func (e Error) Error() string {
-	if e.Name == "" {
+	field := e.Field()
+	if field == "" {
 		return e.Err.Error()
 	}
-	return e.Name + ": " + e.Err.Error()
+	return field + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Field returns the rendered path of the error, or Name if the error has no Path.
+func (e Error) Field() string {
+	if len(e.Path) == 0 {
+		return e.Name
+	}
+	return e.Path.String()
+}
+
+// withParent returns a copy of the error located under parent. The error without Path
+// is located by the deprecated Name, a field segment per dot-separated part.
+func (e *Error) withParent(parent Path) *Error {
+	err := *e
+	child := e.Path
+	if len(child) == 0 && e.Name != "" {
+		for _, name := range strings.Split(e.Name, ".") {
+			child = append(child, PathField(name))
+		}
+	}
+	err.Path = parent.Join(child)
+	err.Name = err.Path.String()
+	return &err
+}
+
+// Message returns the error message without the field name.
+func (e Error) Message() string {
+	return e.Err.Error()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.toJSON())
+}
+
+// jsonError is the stable JSON schema of Error.
+type jsonError struct {
+	Field   string   `json:"field"`
+	Code    string   `json:"code"`
+	Params  []string `json:"params"`
+	Message string   `json:"message"`
+}
+
+func (e Error) toJSON() jsonError {
+	params := e.Params
+	if params == nil {
+		params = []string{}
+	}
+	return jsonError{
+		Field:   e.Field(),
+		Code:    e.Code,
+		Params:  params,
+		Message: e.Message(),
+	}
 }
 
 func ErrNotExpectedType(got, expected string) error {
@@ -83,6 +258,14 @@ func ErrUnknownTagValidator(name string) error {
 	return fmt.Errorf("unknown tag validator: %v", name)
 }
 
+func ErrUnknownMessageKey(key string) error {
+	return fmt.Errorf("unknown message key: %v", key)
+}
+
+func ErrAliasCycle(name string) error {
+	return fmt.Errorf("alias cycle: %v", name)
+}
+
 func ErrNotIndirectType(typ reflect.Type) error {
 	return fmt.Errorf("expected type(ptr,slice,array,map), but got %v", typ)
 }
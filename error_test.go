@@ -0,0 +1,206 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stn81/dynamic"
+	"github.com/stretchr/testify/require"
+)
+
+type stErrorsUser struct {
+	Name  string `json:"name" valid:"required;alpha"`
+	Email string `json:"email" valid:"email"`
+}
+
+type stErrors struct {
+	User  *stErrorsUser            `json:"user"`
+	Users map[string]*stErrorsUser `json:"users"`
+	Age   int                      `json:"age" valid:"range(1,100)"`
+}
+
+func TestErrorsQuery(t *testing.T) {
+	st := &stErrors{
+		User: &stErrorsUser{Name: "123", Email: "bad"},
+		Users: map[string]*stErrorsUser{
+			"b": {Name: "b"},
+			"a": {Name: ""},
+		},
+	}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+
+	errs := err.(Errors)
+	require.Equal(t, []string{"user.name", "user.email", "users[a].name", "age"}, errs.Fields())
+	require.Len(t, errs.ByField("user.name"), 1)
+	require.Len(t, errs.ByPrefix("user"), 2)
+	require.Len(t, errs.ByPrefix("users"), 1)
+	require.Len(t, errs.ByPrefix("users[a]"), 1)
+	require.True(t, errs.HasField("age"))
+	require.False(t, errs.HasField("user"))
+	require.True(t, errs.HasCode("range"))
+	require.True(t, errs.HasCode("required"))
+	require.False(t, errs.HasCode("length"))
+
+	// the params are not shared with the cached tag validator
+	ageErr := errs.FindByName("age")
+	ageErr.Params[0] = "changed"
+	errs = ValidateStruct(st).(Errors)
+	require.Equal(t, "1", errs.FindByName("age").Params[0])
+	require.Equal(t, map[string][]string{
+		"user.name":     {ErrInvalidAlpha.Error()},
+		"user.email":    {ErrInvalidEmail.Error()},
+		"users[a].name": {ErrIsRequired.Error()},
+		"age":           {ErrNotInRange(0, "1", "100").Error()},
+	}, errs.Map())
+}
+
+func TestErrorsAppend(t *testing.T) {
+	shared := &Error{Code: "required", Err: ErrIsRequired}
+	nested := Errors{{Path: Path{PathField("c")}, Err: ErrIsRequired}}
+
+	var errs Errors
+	errs.Append(errors.New("bad"), "a")
+	errs.AppendPath(errors.New("bad"), PathField("a"))
+	errs.Append(shared, "b")
+	errs.AppendPath(shared, PathField("b"), PathIndex(1))
+	errs.AppendPath(nested, PathField("d"), PathKey("k"))
+	errs.Append(nested, "e")
+	require.Len(t, errs, 5)
+	require.Equal(t, []string{"a", "b", "b[1]", "d[k].c", "e.c"}, errs.Fields())
+	require.Equal(t, "required", errs.FindByName("b").Code)
+	require.Equal(t, "d[k].c", errs[3].Name)
+	require.Equal(t, "x: bad", (&Error{Name: "x", Err: errors.New("bad")}).Error())
+
+	// the errors without Path are located by the deprecated Name
+	var named Errors
+	named.Append(&Error{Name: "c", Err: errors.New("bad")}, "d")
+	require.Equal(t, "d.c: bad", named.Error())
+	inner := Errors{{Name: "x.y", Err: errors.New("bad")}}
+	var outer, top Errors
+	outer.Append(inner, "b")
+	top.Append(outer, "a")
+	require.Equal(t, "a.b.x.y: bad", top.Error())
+	require.Equal(t, "a.b.x.y", top[0].Name)
+
+	// the appended errors are never modified
+	require.Empty(t, shared.Path)
+	require.Equal(t, "c", nested[0].Field())
+	require.Equal(t, "is required", shared.Error())
+}
+
+func TestPathString(t *testing.T) {
+	require.Equal(t, "", Path{}.String())
+	require.Equal(t, "a", Path{PathField("a"), PathField("")}.String())
+	require.Equal(t, "a", Path{PathField(""), PathField("a")}.String())
+	require.Equal(t, "a[0][k].b", Path{PathField("a"), PathIndex(0), PathKey("k"), PathField("b")}.String())
+	require.Equal(t, "[1].b", Path{PathIndex(1), PathField("b")}.String())
+	require.Equal(t, `m["a.b"]["x[0]"][""]`, Path{PathField("m"), PathKey("a.b"), PathKey("x[0]"), PathKey("")}.String())
+	require.Equal(t, `m["say \"hi\""]`, Path{PathField("m"), PathKey(`say "hi"`)}.String())
+}
+
+func TestDivePath(t *testing.T) {
+	st := &stNilDiveStruct{
+		Values: []*stEmbeded{nil, {1}, {0}},
+	}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+	require.Equal(t, []string{"Values[2].id"}, err.(Errors).Fields())
+
+	// validate twice with the same cached validators, the paths are not prefixed again
+	err = ValidateStruct(st)
+	require.Equal(t, []string{"Values[2].id"}, err.(Errors).Fields())
+}
+
+func TestErrorsMarshalJSON(t *testing.T) {
+	st := &stErrorsUser{Name: "", Email: "bad"}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+
+	data, jsonErr := json.Marshal(err)
+	require.NoError(t, jsonErr)
+	require.JSONEq(t, `[
+		{"field":"name","code":"required","params":[],"message":"is required"},
+		{"field":"email","code":"email","params":[],"message":"invalid email"}
+	]`, string(data))
+
+	data, jsonErr = json.Marshal(&Error{Path: Path{PathField("age")}, Code: "range", Params: []string{"1", "100"}, Err: ErrNotInRange(0, "1", "100")})
+	require.NoError(t, jsonErr)
+	require.JSONEq(t, `{"field":"age","code":"range","params":["1","100"],"message":"should in range [1, 100], but got 0"}`, string(data))
+
+	data, jsonErr = json.Marshal(Errors{})
+	require.NoError(t, jsonErr)
+	require.Equal(t, "[]", string(data))
+}
+
+func TestWriteProblem(t *testing.T) {
+	st := &stErrors{User: &stErrorsUser{Name: "abc"}, Age: 200}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, WriteProblem(w, err))
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, ProblemContentType, w.Header().Get("Content-Type"))
+	require.JSONEq(t, `{
+		"type": "about:blank",
+		"title": "Bad Request",
+		"status": 400,
+		"invalid-params": [
+			{"name": "age", "reason": "should in range [1, 100], but got 200", "code": "range", "params": ["1", "100"]}
+		]
+	}`, w.Body.String())
+
+	p := NewProblem(errors.New("bad body"))
+	require.Equal(t, "bad body", p.Detail)
+	require.Empty(t, p.InvalidParams)
+}
+
+type stUnwrapInner struct {
+	Name string `json:"name" valid:"required"`
+}
+
+type stUnwrap struct {
+	Inner   stUnwrapInner    `json:"inner"`
+	Items   []*stUnwrapInner `json:"items"`
+	Content *dynamic.Type    `json:"content"`
+	Port    string           `json:"port" valid:"port"`
+}
+
+func TestErrorsIsAs(t *testing.T) {
+	err := ValidateStruct(&stUnwrap{Inner: stUnwrapInner{"a"}, Port: "x"})
+	require.True(t, errors.Is(err, ErrInvalidPort))
+	require.False(t, errors.Is(err, ErrIsRequired))
+
+	// nested struct
+	err = ValidateStruct(&stUnwrap{})
+	require.True(t, errors.Is(err, ErrIsRequired))
+
+	var e *Error
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, "inner.name", e.Field())
+
+	// dive
+	err = ValidateStruct(&stUnwrap{Inner: stUnwrapInner{"a"}, Items: []*stUnwrapInner{{"a"}, {}}})
+	require.True(t, errors.Is(err, ErrIsRequired))
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, "items[1].name", e.Field())
+
+	// dynamic field
+	err = ValidateStruct(&stUnwrap{Inner: stUnwrapInner{"a"}, Content: dynamic.New(stUnwrapInner{})})
+	require.True(t, errors.Is(err, ErrIsRequired))
+	require.True(t, errors.As(err, &e))
+	require.Equal(t, "content.name", e.Field())
+
+	// wrapped
+	wrapped := fmt.Errorf("bad request: %w", err)
+	require.True(t, errors.Is(wrapped, ErrIsRequired))
+	var errs Errors
+	require.True(t, errors.As(wrapped, &errs))
+	require.Len(t, errs, 1)
+	require.Len(t, NewProblem(wrapped).InvalidParams, 1)
+}
@@ -0,0 +1,363 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FileSystem is the file system used by file_exists and dir_exists, nil means the OS file system.
+// The fs.FS paths are slash-separated and unrooted, the leading slash of the validated path is removed.
+var FileSystem fs.FS
+
+// sniffLen is the max bytes used by http.DetectContentType.
+const sniffLen = 512
+
+var (
+	ErrInvalidFilePath = errors.New("invalid file path")
+	ErrInvalidDirPath  = errors.New("invalid dir path")
+	ErrNotAbsPath      = errors.New("not absolute path")
+	ErrFileNotExist    = errors.New("file not exist")
+	ErrDirNotExist     = errors.New("dir not exist")
+	ErrInvalidFileExt  = errors.New("invalid file extension")
+	ErrInvalidMIME     = errors.New("invalid mime type")
+	ErrTooLarge        = errors.New("too large")
+	ErrInvalidByteSize = errors.New("invalid byte size")
+	ErrNotSizable      = errors.New("not string, bytes, file header or integer")
+)
+
+func ErrFileExt(ext string, exts []string) error {
+	return fmt.Errorf("%w: should be one of [%v], but got %q", ErrInvalidFileExt, strings.Join(exts, ","), ext)
+}
+
+func ErrMIME(mediaType string, patterns []string) error {
+	return fmt.Errorf("%w: should be one of [%v], but got %v", ErrInvalidMIME, strings.Join(patterns, ","), mediaType)
+}
+
+func ErrBytesTooLarge(size, max int64) error {
+	return fmt.Errorf("%w: should be at most %v bytes, but got %v", ErrTooLarge, max, size)
+}
+
+// byteUnits are the units of the human-readable byte sizes.
+var byteUnits = map[string]int64{
+	"":    1,
+	"B":   1,
+	"KB":  1000,
+	"MB":  1000 * 1000,
+	"GB":  1000 * 1000 * 1000,
+	"TB":  1000 * 1000 * 1000 * 1000,
+	"K":   1024,
+	"M":   1024 * 1024,
+	"G":   1024 * 1024 * 1024,
+	"T":   1024 * 1024 * 1024 * 1024,
+	"KIB": 1024,
+	"MIB": 1024 * 1024,
+	"GIB": 1024 * 1024 * 1024,
+	"TIB": 1024 * 1024 * 1024 * 1024,
+}
+
+// ParseBytes parses the human-readable byte size like 512, 1.5KB, 5MiB, 10M.
+// KB, MB, GB, TB are decimal units, KiB, MiB, GiB, TiB and K, M, G, T are binary units.
+func ParseBytes(str string) (int64, error) {
+	str = strings.TrimSpace(str)
+	p := strings.IndexFunc(str, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r == '.')
+	})
+	if p == -1 {
+		p = len(str)
+	}
+
+	number, unit := str[:p], strings.ToUpper(strings.TrimSpace(str[p:]))
+	multiplier, ok := byteUnits[unit]
+	if !ok || number == "" {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidByteSize, str)
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil || f*float64(multiplier) >= math.MaxInt64 {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidByteSize, str)
+	}
+	return int64(f * float64(multiplier)), nil
+}
+
+// IsFilePath check if the string is a valid file path, which does not contain NUL
+// and does not end with the path separator. Empty string is valid.
+func IsFilePath(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if strings.IndexByte(str, 0) != -1 || os.IsPathSeparator(str[len(str)-1]) {
+		return ErrInvalidFilePath
+	}
+	if base := filepath.Base(str); base == "." || base == ".." {
+		return ErrInvalidFilePath
+	}
+	return nil
+}
+
+// IsDirPath check if the string is a valid directory path, which does not contain NUL.
+// Empty string is valid.
+func IsDirPath(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || strings.IndexByte(str, 0) == -1 {
+		return nil
+	}
+	return ErrInvalidDirPath
+}
+
+// IsAbsPath check if the string is an absolute path of the OS. Empty string is valid.
+func IsAbsPath(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if strings.IndexByte(str, 0) != -1 || !filepath.IsAbs(str) {
+		return ErrNotAbsPath
+	}
+	return nil
+}
+
+// statPath returns the file info from FileSystem, or the OS file system if FileSystem is nil.
+func statPath(name string) (fs.FileInfo, error) {
+	if FileSystem == nil {
+		return os.Stat(name)
+	}
+
+	name = strings.TrimPrefix(path.Clean(filepath.ToSlash(name)), "/")
+	if name == "" {
+		name = "."
+	}
+	return fs.Stat(FileSystem, name)
+}
+
+// FileExists check if the path is an existing regular file. Empty string is valid.
+func FileExists(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	info, err := statPath(str)
+	if err != nil || !info.Mode().IsRegular() {
+		return ErrFileNotExist
+	}
+	return nil
+}
+
+// DirExists check if the path is an existing directory. Empty string is valid.
+func DirExists(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	info, err := statPath(str)
+	if err != nil || !info.IsDir() {
+		return ErrDirNotExist
+	}
+	return nil
+}
+
+// FileExtValidator checks the file extension is one of Exts, case-insensitively.
+// The value can be the file name string or *multipart.FileHeader.
+type FileExtValidator struct {
+	Exts []string
+}
+
+// Validate implements the Validator interface. Empty value is valid.
+func (v *FileExtValidator) Validate(value interface{}, args ...string) error {
+	var name string
+	if header, ok := value.(*multipart.FileHeader); ok {
+		if header == nil {
+			return nil
+		}
+		name = header.Filename
+	} else {
+		name = assertString(value)
+	}
+	if name == "" {
+		return nil
+	}
+
+	ext := filepath.Ext(name)
+	for _, expected := range v.Exts {
+		if strings.EqualFold(ext, expected) {
+			return nil
+		}
+	}
+	return ErrFileExt(ext, v.Exts)
+}
+
+// CompileFileExt creates the validator of tag `file_ext(.png,.jpg)`, the leading dot is optional.
+func CompileFileExt(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("file_ext", 1))
+	}
+
+	exts := make([]string, 0, len(args))
+	for _, arg := range args {
+		arg = strings.TrimSpace(arg)
+		if !strings.HasPrefix(arg, ".") {
+			arg = "." + arg
+		}
+		exts = append(exts, arg)
+	}
+	return &FileExtValidator{Exts: exts}
+}
+
+// MIMEValidator checks the media type matches one of Patterns like image/png or image/*.
+// The []byte and *multipart.FileHeader values are sniffed by http.DetectContentType,
+// the string value is parsed as the media type.
+type MIMEValidator struct {
+	Patterns []string
+}
+
+// Validate implements the Validator interface. Empty value is valid.
+func (v *MIMEValidator) Validate(value interface{}, args ...string) error {
+	var mediaType string
+	switch val := value.(type) {
+	case []byte:
+		if len(val) == 0 {
+			return nil
+		}
+		mediaType = http.DetectContentType(val)
+	case *multipart.FileHeader:
+		if val == nil {
+			return nil
+		}
+		detected, err := sniffFileHeader(val)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidMIME, err)
+		}
+		mediaType = detected
+	default:
+		mediaType = assertString(value)
+		if mediaType == "" {
+			return nil
+		}
+	}
+
+	parsed, _, err := mime.ParseMediaType(mediaType)
+	if err != nil {
+		return ErrMIME(mediaType, v.Patterns)
+	}
+	for _, pattern := range v.Patterns {
+		if matchMediaType(pattern, parsed) {
+			return nil
+		}
+	}
+	return ErrMIME(parsed, v.Patterns)
+}
+
+func sniffFileHeader(header *multipart.FileHeader) (string, error) {
+	file, err := header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	buf := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// matchMediaType matches the media type with pattern like image/png, image/* or */*.
+func matchMediaType(pattern, mediaType string) bool {
+	if pattern == "*/*" || strings.EqualFold(pattern, mediaType) {
+		return true
+	}
+	if strings.HasSuffix(pattern, "/*") {
+		return strings.HasPrefix(mediaType, strings.ToLower(pattern[:len(pattern)-1]))
+	}
+	return false
+}
+
+// CompileMIME creates the validator of tag `mime(image/*,application/pdf)`.
+func CompileMIME(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("mime", 1))
+	}
+
+	patterns := make([]string, 0, len(args))
+	for _, arg := range args {
+		patterns = append(patterns, strings.ToLower(strings.TrimSpace(arg)))
+	}
+	return &MIMEValidator{Patterns: patterns}
+}
+
+// MaxBytesValidator checks the size of string, []byte or *multipart.FileHeader, or the
+// integer of size is at most Max bytes.
+type MaxBytesValidator struct {
+	Max int64
+}
+
+// Validate implements the Validator interface.
+func (v *MaxBytesValidator) Validate(value interface{}, args ...string) error {
+	var size int64
+	if header, ok := value.(*multipart.FileHeader); ok {
+		if header == nil {
+			return nil
+		}
+		size = header.Size
+	} else {
+		val := reflect.ValueOf(value)
+		switch val.Kind() {
+		case reflect.String:
+			size = int64(val.Len())
+		case reflect.Slice:
+			if val.Type().Elem().Kind() != reflect.Uint8 {
+				panic(ErrNotSizable)
+			}
+			size = int64(val.Len())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			size = val.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if val.Uint() > math.MaxInt64 {
+				return ErrBytesTooLarge(math.MaxInt64, v.Max)
+			}
+			size = int64(val.Uint())
+		default:
+			panic(ErrNotSizable)
+		}
+	}
+
+	if size > v.Max {
+		return ErrBytesTooLarge(size, v.Max)
+	}
+	return nil
+}
+
+// CompileMaxBytes creates the validator of tag `max_bytes(5MiB)`.
+func CompileMaxBytes(args ...string) Validator {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("max_bytes", 1))
+	}
+
+	max, err := ParseBytes(args[0])
+	if err != nil {
+		panic(err)
+	}
+	return &MaxBytesValidator{Max: max}
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("file_ext", CompileFileExt)
+	TagValidatorMap.RegisterCompileFunc("mime", CompileMIME)
+	TagValidatorMap.RegisterCompileFunc("max_bytes", CompileMaxBytes)
+}
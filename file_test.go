@@ -0,0 +1,220 @@
+package govalidator
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBytes(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected int64
+		valid    bool
+	}{
+		{"512", 512, true},
+		{"512B", 512, true},
+		{"1.5KB", 1500, true},
+		{"5MiB", 5 << 20, true},
+		{"5 mib", 5 << 20, true},
+		{"10M", 10 << 20, true},
+		{"1GB", 1000 * 1000 * 1000, true},
+		{"", 0, false},
+		{"MB", 0, false},
+		{"5XB", 0, false},
+		{"1.2.3KB", 0, false},
+		{"8388607TiB", 8388607 << 40, true},
+		{"8388608TiB", 0, false},
+		{"9223372036854775808", 0, false},
+	}
+	for _, test := range tests {
+		size, err := ParseBytes(test.param)
+		if test.valid {
+			require.NoError(t, err, "check ParseBytes(%s)", test.param)
+			require.Equal(t, test.expected, size, "check ParseBytes(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidByteSize, "check ParseBytes(%s)", test.param)
+		}
+	}
+}
+
+func TestIsPath(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		filePath error
+		dirPath  error
+		absPath  error
+	}{
+		{"", nil, nil, nil},
+		{"/etc/hosts", nil, nil, nil},
+		{"config/app.yaml", nil, nil, ErrNotAbsPath},
+		{"/var/log/", ErrInvalidFilePath, nil, nil},
+		{"..", ErrInvalidFilePath, nil, ErrNotAbsPath},
+		{"/tmp/a\x00b", ErrInvalidFilePath, ErrInvalidDirPath, ErrNotAbsPath},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.filePath, IsFilePath(test.param), "check IsFilePath(%q)", test.param)
+		require.Equal(t, test.dirPath, IsDirPath(test.param), "check IsDirPath(%q)", test.param)
+		require.Equal(t, test.absPath, IsAbsPath(test.param), "check IsAbsPath(%q)", test.param)
+	}
+}
+
+func TestFileExists(t *testing.T) {
+	FileSystem = fstest.MapFS{
+		"etc/app.yaml":    {Data: []byte("debug: true")},
+		"var/log/app.log": {},
+	}
+	defer func() { FileSystem = nil }()
+
+	var tests = []struct {
+		param      string
+		fileExists error
+		dirExists  error
+	}{
+		{"", nil, nil},
+		{"/etc/app.yaml", nil, ErrDirNotExist},
+		{"etc/app.yaml", nil, ErrDirNotExist},
+		{"/var/log", ErrFileNotExist, nil},
+		{"/", ErrFileNotExist, nil},
+		{"/etc/missing.yaml", ErrFileNotExist, ErrDirNotExist},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.fileExists, FileExists(test.param), "check FileExists(%s)", test.param)
+		require.Equal(t, test.dirExists, DirExists(test.param), "check DirExists(%s)", test.param)
+	}
+}
+
+func TestFileExt(t *testing.T) {
+	t.Parallel()
+
+	v := CompileFileExt(".png", "jpg")
+
+	var tests = []struct {
+		param interface{}
+		valid bool
+	}{
+		{"", true},
+		{"avatar.png", true},
+		{"photos/avatar.JPG", true},
+		{&multipart.FileHeader{Filename: "avatar.jpg"}, true},
+		{"avatar.gif", false},
+		{"avatar", false},
+		{&multipart.FileHeader{Filename: "avatar.png.exe"}, false},
+	}
+	for _, test := range tests {
+		err := v.Validate(test.param)
+		if test.valid {
+			require.NoError(t, err, "check file_ext(%v)", test.param)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidFileExt, "check file_ext(%v)", test.param)
+		}
+	}
+}
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newFileHeader(t *testing.T, filename string, content []byte) *multipart.FileHeader {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", filename)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	require.NoError(t, req.ParseMultipartForm(1<<20))
+	return req.MultipartForm.File["file"][0]
+}
+
+func TestMIME(t *testing.T) {
+	t.Parallel()
+
+	v := CompileMIME("image/*", "application/pdf")
+
+	var tests = []struct {
+		param interface{}
+		valid bool
+	}{
+		{[]byte(nil), true},
+		{"", true},
+		{pngHeader, true},
+		{[]byte("%PDF-1.7\n"), true},
+		{"image/jpeg", true},
+		{"Application/PDF; charset=binary", true},
+		{newFileHeader(t, "avatar.png", pngHeader), true},
+		{[]byte("<html><body></body></html>"), false},
+		{"text/plain", false},
+		{"image", false},
+		{newFileHeader(t, "avatar.png", []byte("not an image")), false},
+	}
+	for _, test := range tests {
+		err := v.Validate(test.param)
+		if test.valid {
+			require.NoError(t, err, "check mime(%v)", test.param)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidMIME, "check mime(%v)", test.param)
+		}
+	}
+}
+
+func TestMaxBytes(t *testing.T) {
+	t.Parallel()
+
+	v := CompileMaxBytes("1KiB")
+
+	var tests = []struct {
+		param interface{}
+		valid bool
+	}{
+		{"", true},
+		{make([]byte, 1024), true},
+		{int64(1024), true},
+		{uint(1024), true},
+		{&multipart.FileHeader{Size: 1024}, true},
+		{make([]byte, 1025), false},
+		{string(make([]byte, 1025)), false},
+		{2048, false},
+		{&multipart.FileHeader{Size: 5 << 20}, false},
+	}
+	for _, test := range tests {
+		err := v.Validate(test.param)
+		if test.valid {
+			require.NoError(t, err, "check max_bytes(%T)", test.param)
+		} else {
+			require.ErrorIs(t, err, ErrTooLarge, "check max_bytes(%T)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompileMaxBytes("5XB") })
+	require.Panics(t, func() { v.Validate([]int{1}) })
+}
+
+func TestValidateStructUpload(t *testing.T) {
+	t.Parallel()
+
+	type Upload struct {
+		Name    string `valid:"file_ext(.png,.jpg)"`
+		Content []byte `valid:"mime(image/*);max_bytes(16B)"`
+	}
+
+	require.NoError(t, ValidateStruct(&Upload{Name: "a.png", Content: pngHeader}))
+
+	err := ValidateStruct(&Upload{Name: "a.gif", Content: []byte("<html><body></body></html>")})
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrInvalidFileExt)
+	require.ErrorIs(t, err, ErrInvalidMIME)
+	require.ErrorIs(t, err, ErrTooLarge)
+	require.True(t, err.(Errors).HasCode("max_bytes"))
+}
@@ -1,10 +1,10 @@
 package govalidator
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -78,12 +78,16 @@ func ErrNotInRange(value interface{}, min, max string) error {
 	return fmt.Errorf("should in range [%v, %v], but got %v", min, max, value)
 }
 
+func ErrNotInInterval(value interface{}, lower, upper string) error {
+	return fmt.Errorf("should in range %v, %v, but got %v", lower, upper, value)
+}
+
 func ErrLessThanMin(value interface{}, min interface{}) error {
-	return fmt.Errorf("should be great than %v, but got %v", min, value)
+	return fmt.Errorf("should be greater than or equal to %v, but got %v", min, value)
 }
 
 func ErrGreatThanMax(value interface{}, max interface{}) error {
-	return fmt.Errorf("should be less than %v, but got %v", max, value)
+	return fmt.Errorf("should be less than or equal to %v, but got %v", max, value)
 }
 
 func ErrInvalidTime(str, format string) error {
@@ -242,39 +246,17 @@ func IsEmpty(value interface{}, args ...string) error {
 	return ErrNotEmpty
 }
 
-// Length check if the string's length (in bytes) falls in a range.
+// Length check if the length of string or []byte falls in a range.
+// The optional third argument is the unit of string: runes (default), bytes or graphemes,
+// the []byte is always counted in bytes.
 func Length(value interface{}, args ...string) error {
-	if len(args) != 2 {
-		panic(ErrNumArgsInvalid("length", 2))
-	}
-
-	var length int
-	switch v := value.(type) {
-	case string:
-		length = utf8.RuneCountInString(v)
-	case []byte:
-		length = len(v)
-	}
-
-	min := GetInt(args[0])
-	max := GetInt(args[1])
-	if length >= min && length <= max {
-		return nil
-	}
-	return ErrInvalidLength(length, min, max)
+	return CompileLength(args...).Validate(value)
 }
 
-// IsJSON check if the string is valid JSON (note: uses json.Unmarshal).
+// IsJSON check if the string is valid JSON (checked by json.Valid), the optional argument
+// like `object|array` constrains the type of the JSON value. Empty string is valid.
 func IsJSON(value interface{}, args ...string) error {
-	str := assertString(value)
-	if str == "" {
-		return nil
-	}
-
-	if json.Valid([]byte(str)) {
-		return nil
-	}
-	return ErrInvalidJSON
+	return CompileJSON(args...).Validate(value)
 }
 
 // IsASCII check if the string contains ASCII chars only. Empty string is valid.
@@ -316,10 +298,12 @@ func IsBase64(value interface{}, args ...string) error {
 
 // IsHash checks if a string is a hash of type algorithm.
 // Algorithm is one of ['md4', 'md5', 'sha1', 'sha256', 'sha384', 'sha512', 'ripemd128', 'ripemd160', 'tiger128', 'tiger160', 'tiger192', 'crc32', 'crc32b']
+// The hex digits should be lowercase, or any case with the option `ci`, e.g. `hash(sha256,ci)`.
 func IsHash(value interface{}, args ...string) error {
-	if len(args) != 1 {
+	if len(args) == 0 {
 		panic(ErrNumArgsInvalid("hash", 1))
 	}
+	caseInsensitive := parseCaseOption("hash", args[1:])
 
 	str := assertString(value)
 	if str == "" {
@@ -348,14 +332,22 @@ func IsHash(value interface{}, args ...string) error {
 		return ErrInvalidHash(algo, str)
 	}
 
-	if matches(str, "^[a-f0-9]{"+length+"}$") {
+	pattern := "^[a-f0-9]{" + length + "}$"
+	if caseInsensitive {
+		pattern = "^[a-fA-F0-9]{" + length + "}$"
+	}
+	if matches(str, pattern) {
 		return nil
 	}
 	return ErrInvalidHash(algo, str)
 }
 
-// IsIP checks if a string is either IP version 4 or 6.
+// IsIP checks if a string, net.IP or netip.Addr is either IP version 4 or 6.
 func IsIP(value interface{}, args ...string) error {
+	if isTypedIP(value) {
+		return validateTypedIP(value, netip.Addr.IsValid, ErrInvalidIP)
+	}
+
 	str := assertString(value)
 	if str == "" {
 		return nil
@@ -380,8 +372,12 @@ func IsPort(value interface{}, args ...string) error {
 	return ErrInvalidPort
 }
 
-// IsIPv4 check if the string is an IP version 4.
+// IsIPv4 check if the string, net.IP or netip.Addr is an IP version 4.
 func IsIPv4(value interface{}, args ...string) error {
+	if isTypedIP(value) {
+		return validateTypedIP(value, func(addr netip.Addr) bool { return addr.Unmap().Is4() }, ErrInvalidIPv4)
+	}
+
 	str := assertString(value)
 	if str == "" {
 		return nil
@@ -394,8 +390,12 @@ func IsIPv4(value interface{}, args ...string) error {
 	return ErrInvalidIPv4
 }
 
-// IsIPv6 check if the string is an IP version 6.
+// IsIPv6 check if the string, net.IP or netip.Addr is an IP version 6.
 func IsIPv6(value interface{}, args ...string) error {
+	if isTypedIP(value) {
+		return validateTypedIP(value, netip.Addr.Is6, ErrInvalidIPv6)
+	}
+
 	str := assertString(value)
 	if str == "" {
 		return nil
@@ -408,8 +408,15 @@ func IsIPv6(value interface{}, args ...string) error {
 	return ErrInvalidIPv6
 }
 
-// IsCIDR check if the string is an valid CIDR notiation (IPV4 & IPV6)
+// IsCIDR check if the string or netip.Prefix is an valid CIDR notiation (IPV4 & IPV6)
 func IsCIDR(value interface{}, args ...string) error {
+	if prefix, ok := value.(netip.Prefix); ok {
+		if prefix == (netip.Prefix{}) || prefix.IsValid() {
+			return nil
+		}
+		return ErrInvalidCIDR
+	}
+
 	str := assertString(value)
 	if str == "" {
 		return nil
@@ -441,28 +448,6 @@ func IsMAC(value interface{}, args ...string) error {
 	return ErrInvalidMAC
 }
 
-// IsLatitude check if a string is valid latitude.
-func IsLatitude(value interface{}, args ...string) error {
-	str := assertString(value)
-	if rxLatitude.MatchString(str) {
-		return nil
-	}
-	return ErrInvalidLatitude
-}
-
-// IsLongitude check if a string is valid longitude.
-func IsLongitude(value interface{}, args ...string) error {
-	str := assertString(value)
-	if str == "" {
-		return nil
-	}
-
-	if rxLongitude.MatchString(str) {
-		return nil
-	}
-	return ErrInvalidLongtitude
-}
-
 // IsTime check if string is valid according to given format
 func IsTime(value interface{}, args ...string) error {
 	if len(args) != 1 {
@@ -491,22 +476,6 @@ func IsRFC3339WithoutZone(value interface{}, args ...string) error {
 	return IsTime(value, RF3339WithoutZone)
 }
 
-// IsISO4217 check if string is valid ISO currency code
-func IsISO4217(value interface{}, args ...string) error {
-	str := assertString(value)
-	if str == "" {
-		return nil
-	}
-
-	for _, currency := range ISO4217List {
-		if str == currency {
-			return nil
-		}
-	}
-
-	return ErrInvalidISO4217CurrencyCode
-}
-
 // RegEx checks if a string matches a given pattern.
 func RegEx(value interface{}, args ...string) error {
 	if len(args) != 1 {
@@ -525,97 +494,24 @@ func RegEx(value interface{}, args ...string) error {
 	return ErrRegexpNotMatch(str, pattern)
 }
 
-// Min check the min value
+// Min check the min value, the value can be any number kinds, *big.Int, *big.Float,
+// json.Number and numeric strings. Empty string is valid.
 func Min(value interface{}, args ...string) error {
-	if len(args) != 1 {
-		panic(ErrNumArgsInvalid("min", 1))
-	}
-
-	switch value.(type) {
-	case int, int8, int16, int32, int64:
-		val := GetInt64(value)
-		min := GetInt64(args[0])
-		if val >= min {
-			return nil
-		}
-	case uint, uint8, uint16, uint32, uint64:
-		val := GetUint64(value)
-		min := GetUint64(args[0])
-		if val >= min {
-			return nil
-		}
-	case float32, float64:
-		val := GetFloat64(value)
-		min := GetFloat64(args[0])
-		if val >= min {
-			return nil
-		}
-	}
-
-	return ErrLessThanMin(value, args[0])
+	return CompileMin(args...).Validate(value)
 }
 
-// Max check the max value
+// Max check the max value, the value can be any number kinds, *big.Int, *big.Float,
+// json.Number and numeric strings. Empty string is valid.
 func Max(value interface{}, args ...string) error {
-	if len(args) != 1 {
-		panic(ErrNumArgsInvalid("max", 1))
-	}
-
-	switch value.(type) {
-	case int, int8, int16, int32, int64:
-		val := GetInt64(value)
-		max := GetInt64(args[0])
-		if val <= max {
-			return nil
-		}
-	case uint, uint8, uint16, uint32, uint64:
-		val := GetUint64(value)
-		max := GetUint64(args[0])
-		if val <= max {
-			return nil
-		}
-	case float32, float64:
-		val := GetFloat64(value)
-		max := GetFloat64(args[0])
-		if val <= max {
-			return nil
-		}
-	}
-
-	return ErrGreatThanMax(value, args[0])
+	return CompileMax(args...).Validate(value)
 }
 
-// Range check value range
+// Range check value range, the value can be any number kinds, *big.Int, *big.Float,
+// json.Number and numeric strings. Empty string is valid.
+// The bounds are inclusive, the interval syntax `range(0,1]`, `range[0,1)` and `range((0,1))`
+// makes the bound with parenthesis exclusive.
 func Range(value interface{}, args ...string) error {
-	if len(args) != 2 {
-		panic(ErrNumArgsInvalid("range", 2))
-	}
-
-	switch value.(type) {
-	case int, int8, int16, int32, int64:
-		val := GetInt64(value)
-		min := GetInt64(args[0])
-		max := GetInt64(args[1])
-		if val >= min && val <= max {
-			return nil
-		}
-	case uint, uint8, uint16, uint32, uint64:
-		val := GetUint64(value)
-		min := GetUint64(args[0])
-		max := GetUint64(args[1])
-		if val >= min && val <= max {
-			return nil
-		}
-	case float32, float64:
-		val := GetFloat64(value)
-		min := GetFloat64(args[0])
-		max := GetFloat64(args[1])
-		if val >= min && val <= max {
-			return nil
-		}
-	}
-
-	return ErrNotInRange(value, args[0], args[1])
+	return CompileRange(args...).Validate(value)
 }
 
 // IsIn check if string str is a member of the set of strings params
@@ -652,6 +548,8 @@ func SkipEmpty(value interface{}, args ...string) error {
 }
 
 // TagMap is a map of functions, that can be used as tags for ValidateStruct function.
+// The tags registered by a compile func, like `email`, `url` and `in`, are kept here for compatibility,
+// but the compile func takes precedence in TagValidatorMap.
 var TagMap = map[string]ValidateFunc{
 	"email":              IsEmail,
 	"url":                IsURL,
@@ -668,6 +566,12 @@ var TagMap = map[string]ValidateFunc{
 	"hash":               IsHash,
 	"printableascii":     IsPrintableASCII,
 	"base64":             IsBase64,
+	"base64url":          IsBase64URL,
+	"base64raw":          IsBase64Raw,
+	"hex":                IsHex,
+	"jwt":                IsJWT,
+	"x509":               IsX509,
+	"semver":             IsSemver,
 	"ip":                 IsIP,
 	"port":               IsPort,
 	"ipv4":               IsIPv4,
@@ -675,9 +579,48 @@ var TagMap = map[string]ValidateFunc{
 	"mac":                IsMAC,
 	"latitude":           IsLatitude,
 	"longitude":          IsLongitude,
+	"latlng":             IsLatLng,
+	"geohash":            IsGeohash,
+	"finite":             IsFinite,
+	"positive":           IsPositive,
+	"negative":           IsNegative,
+	"nonzero":            IsNonZero,
+	"gt":                 GreaterThan,
+	"gte":                GreaterOrEqual,
+	"lt":                 LessThan,
+	"lte":                LessOrEqual,
+	"eq":                 Equal,
+	"ne":                 NotEqual,
+	"luhn":               IsLuhn,
+	"iban":               IsIBAN,
+	"bic":                IsBIC,
+	"isbn10":             IsISBN10,
+	"isbn13":             IsISBN13,
+	"ean8":               IsEAN8,
+	"ean13":              IsEAN13,
+	"upc":                IsUPC,
+	"cn_id_card":         IsCNIDCard,
+	"cn_uscc":            IsCNUSCC,
+	"cn_mobile":          IsCNMobile,
+	"cn_postcode":        IsCNPostcode,
+	"cn_plate":           IsCNPlate,
+	"filepath":           IsFilePath,
+	"abspath":            IsAbsPath,
+	"dirpath":            IsDirPath,
+	"file_exists":        FileExists,
+	"dir_exists":         DirExists,
 	"rfc3339":            IsRFC3339,
 	"rfc3339WithoutZone": IsRFC3339WithoutZone,
 	"ISO4217":            IsISO4217,
+	"iso4217":            IsISO4217,
+	"iso4217_numeric":    IsISO4217Numeric,
+	"iso3166_alpha2":     IsISO3166Alpha2,
+	"iso3166_alpha3":     IsISO3166Alpha3,
+	"iso3166_numeric":    IsISO3166Numeric,
+	"iso639_1":           IsISO639Alpha2,
+	"iso639_2":           IsISO639Alpha3,
+	"bcp47":              IsBCP47,
+	"timezone":           IsTimezone,
 	"required":           Required,
 	"in":                 IsIn,
 	"min":                Min,
@@ -686,10 +629,65 @@ var TagMap = map[string]ValidateFunc{
 	"length":             Length,
 	"skipempty":          SkipEmpty,
 	"regex":              RegEx,
+	"uuid":               IsUUID,
+	"ulid":               IsULID,
+	"ksuid":              IsKSUID,
+	"objectid":           IsObjectID,
+	"snowflake":          IsSnowflake,
+	"hostname":           IsHostname,
+	"fqdn":               IsFQDN,
+	"dns_label":          IsDNSLabel,
+	"domain":             IsDomain,
+	"hostport":           IsHostPort,
+	"http_url":           IsHTTPURL,
+	"uri":                IsURI,
+	"urn":                IsURN,
+	"cidr":               IsCIDR,
+	"cidrv4":             IsCIDRv4,
+	"cidrv6":             IsCIDRv6,
+	"public_ip":          IsPublicIP,
+	"private_ip":         IsPrivateIP,
+	"loopback":           IsLoopbackIP,
+	"contains_lower":     ContainsLower,
+	"contains_upper":     ContainsUpper,
+	"contains_digit":     ContainsDigit,
+	"contains_symbol":    ContainsSymbol,
+	"no_whitespace":      NoWhitespace,
+	"utf_letter":         IsUTFLetter,
+	"utf_letter_num":     IsUTFLetterNumeric,
+	"utf_digit":          IsUTFDigit,
+	"nfc":                IsNFC,
+	"no_control":         NoControl,
+	"no_bidi":            NoBidi,
+}
+
+// TagParamNames is the argument names of the tags, used as the custom message placeholders.
+var TagParamNames = map[string][]string{
+	"hash":        {"algorithm"},
+	"min":         {"min"},
+	"max":         {"max"},
+	"range":       {"min", "max"},
+	"length":      {"min", "max", "unit"},
+	"regex":       {"pattern"},
+	"uuid":        {"version"},
+	"decimal":     {"precision", "scale"},
+	"multiple_of": {"step"},
+	"gt":          {"min"},
+	"gte":         {"min"},
+	"lt":          {"max"},
+	"lte":         {"max"},
+	"eq":          {"expected"},
+	"ne":          {"expected"},
 }
 
 func init() {
 	for tag, validator := range TagMap {
+		if _, ok := TagValidatorMap.Get(tag).(CompileFunc); ok {
+			continue
+		}
 		TagValidatorMap.RegisterValidateFunc(tag, validator)
 	}
+	for tag, params := range TagParamNames {
+		TagValidatorMap.RegisterParamNames(tag, params...)
+	}
 }
@@ -0,0 +1,431 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+var (
+	ErrNotCoordinate   = errors.New("not a coordinate, should be number or string")
+	ErrInvalidLatLng   = errors.New("invalid latlng")
+	ErrInvalidGeohash  = errors.New("invalid geohash")
+	ErrInvalidGeoJSON  = errors.New("invalid geojson")
+	ErrNotInBBox       = errors.New("not in bbox")
+	ErrInvalidBBoxArgs = errors.New("invalid bbox args, should be minLat,minLng,maxLat,maxLng")
+)
+
+func ErrGeoJSON(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %v", ErrInvalidGeoJSON, fmt.Sprintf(format, args...))
+}
+
+func ErrGeoJSONType(got string, expected ...string) error {
+	return ErrGeoJSON("type should be one of [%v], but got %v", strings.Join(expected, ","), got)
+}
+
+// coordinate converts a number or numeric string value to float64.
+// The ok is false if the value is empty string.
+func coordinate(value interface{}, rx func(string) bool, invalid error) (f float64, ok bool, err error) {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f = val.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f = float64(val.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		f = float64(val.Uint())
+	case reflect.String:
+		str := strings.TrimSpace(val.String())
+		if str == "" {
+			return 0, false, nil
+		}
+		if !rx(str) {
+			return 0, false, invalid
+		}
+		f, _ = strconv.ParseFloat(str, 64)
+	default:
+		panic(ErrNotCoordinate)
+	}
+	return f, true, nil
+}
+
+func isLatitude(f float64) bool {
+	return f >= -90 && f <= 90
+}
+
+func isLongitude(f float64) bool {
+	return f >= -180 && f <= 180
+}
+
+// IsLatitude check if a number or string is valid latitude. Empty string is invalid.
+func IsLatitude(value interface{}, args ...string) error {
+	f, ok, err := coordinate(value, rxLatitude.MatchString, ErrInvalidLatitude)
+	if err != nil {
+		return err
+	}
+	if !ok || !isLatitude(f) {
+		return ErrInvalidLatitude
+	}
+	return nil
+}
+
+// IsLongitude check if a number or string is valid longitude. Empty string is valid.
+func IsLongitude(value interface{}, args ...string) error {
+	f, ok, err := coordinate(value, rxLongitude.MatchString, ErrInvalidLongtitude)
+	if err != nil || !ok {
+		return err
+	}
+	if !isLongitude(f) {
+		return ErrInvalidLongtitude
+	}
+	return nil
+}
+
+// latLng converts the value to a latitude and longitude pair. The value is a "lat,lng" string,
+// or a slice or array of 2 numbers in the order of latitude, longitude.
+// The ok is false if the value is empty.
+func latLng(value interface{}) (lat, lng float64, ok bool, err error) {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.String:
+		str := val.String()
+		if str == "" {
+			return 0, 0, false, nil
+		}
+		parts := strings.Split(str, ",")
+		if len(parts) != 2 {
+			return 0, 0, false, ErrInvalidLatLng
+		}
+		if lat, _, err = coordinate(strings.TrimSpace(parts[0]), rxLatitude.MatchString, ErrInvalidLatitude); err != nil {
+			return 0, 0, false, err
+		}
+		if lng, _, err = coordinate(strings.TrimSpace(parts[1]), rxLongitude.MatchString, ErrInvalidLongtitude); err != nil {
+			return 0, 0, false, err
+		}
+	case reflect.Slice, reflect.Array:
+		if val.Kind() == reflect.Slice && val.Len() == 0 {
+			return 0, 0, false, nil
+		}
+		if val.Len() != 2 {
+			return 0, 0, false, ErrInvalidLatLng
+		}
+		if lat, _, err = coordinate(val.Index(0).Interface(), rxLatitude.MatchString, ErrInvalidLatitude); err != nil {
+			return 0, 0, false, err
+		}
+		if lng, _, err = coordinate(val.Index(1).Interface(), rxLongitude.MatchString, ErrInvalidLongtitude); err != nil {
+			return 0, 0, false, err
+		}
+	default:
+		panic(ErrNotCoordinate)
+	}
+
+	if !isLatitude(lat) {
+		return 0, 0, false, ErrInvalidLatitude
+	}
+	if !isLongitude(lng) {
+		return 0, 0, false, ErrInvalidLongtitude
+	}
+	return lat, lng, true, nil
+}
+
+// IsLatLng check if the value is a valid "lat,lng" string, or a [2]float64 / []float64 of
+// latitude and longitude. Empty value is valid.
+func IsLatLng(value interface{}, args ...string) error {
+	_, _, _, err := latLng(value)
+	return err
+}
+
+// IsGeohash check if the string is a valid geohash of 1 to 12 lowercase base32 characters.
+// Empty string is valid.
+func IsGeohash(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if len(str) > 12 {
+		return ErrInvalidGeohash
+	}
+	for i := 0; i < len(str); i++ {
+		if strings.IndexByte(geohashAlphabet, str[i]) == -1 {
+			return ErrInvalidGeohash
+		}
+	}
+	return nil
+}
+
+// BBoxValidator checks the latlng value is contained in the bounding box.
+// The box crosses the antimeridian if MinLng is greater than MaxLng.
+type BBoxValidator struct {
+	MinLat, MinLng, MaxLat, MaxLng float64
+}
+
+// Validate implements the Validator interface. Empty value is valid.
+func (v *BBoxValidator) Validate(value interface{}, args ...string) error {
+	lat, lng, ok, err := latLng(value)
+	if err != nil || !ok {
+		return err
+	}
+
+	if lat < v.MinLat || lat > v.MaxLat {
+		return ErrNotInBBox
+	}
+	if v.MinLng <= v.MaxLng {
+		if lng < v.MinLng || lng > v.MaxLng {
+			return ErrNotInBBox
+		}
+	} else if lng < v.MinLng && lng > v.MaxLng {
+		return ErrNotInBBox
+	}
+	return nil
+}
+
+// CompileBBox creates the validator of tag `bbox(minLat,minLng,maxLat,maxLng)`.
+func CompileBBox(args ...string) Validator {
+	if len(args) != 4 {
+		panic(ErrNumArgsInvalid("bbox", 4))
+	}
+
+	var box [4]float64
+	for i, arg := range args {
+		f, err := strconv.ParseFloat(strings.TrimSpace(arg), 64)
+		if err != nil {
+			panic(ErrInvalidBBoxArgs)
+		}
+		box[i] = f
+	}
+
+	v := &BBoxValidator{MinLat: box[0], MinLng: box[1], MaxLat: box[2], MaxLng: box[3]}
+	if !isLatitude(v.MinLat) || !isLatitude(v.MaxLat) || v.MinLat > v.MaxLat ||
+		!isLongitude(v.MinLng) || !isLongitude(v.MaxLng) {
+		panic(ErrInvalidBBoxArgs)
+	}
+	return v
+}
+
+// GeoJSONTypes are the object types of RFC 7946.
+var GeoJSONTypes = []string{
+	"Point", "MultiPoint", "LineString", "MultiLineString", "Polygon", "MultiPolygon",
+	"GeometryCollection", "Feature", "FeatureCollection",
+}
+
+// geoJSONObject is the union of the members of GeoJSON objects.
+type geoJSONObject struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Geometries  []json.RawMessage `json:"geometries"`
+	Geometry    json.RawMessage   `json:"geometry"`
+	Properties  json.RawMessage   `json:"properties"`
+	Features    []json.RawMessage `json:"features"`
+}
+
+// GeoJSONValidator checks the value is a GeoJSON object of the types, with the valid
+// coordinates and closed polygon rings.
+type GeoJSONValidator struct {
+	Types []string
+}
+
+// Validate implements the Validator interface, the value can be string, []byte or json.RawMessage.
+// Empty value is valid.
+func (v *GeoJSONValidator) Validate(value interface{}, args ...string) error {
+	var data []byte
+	if raw, ok := value.(json.RawMessage); ok {
+		data = raw
+	} else {
+		data = GetByteArray(value)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	var obj geoJSONObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return ErrGeoJSON("%v", err)
+	}
+	if len(v.Types) > 0 && !containsString(v.Types, obj.Type) {
+		return ErrGeoJSONType(obj.Type, v.Types...)
+	}
+	return validateGeoJSON(&obj)
+}
+
+// CompileGeoJSON creates the validator of tag `geojson` or `geojson(Point|Polygon)`.
+func CompileGeoJSON(args ...string) Validator {
+	var types []string
+	for _, arg := range args {
+		for _, typ := range strings.Split(arg, "|") {
+			if !containsString(GeoJSONTypes, typ) {
+				panic(ErrGeoJSONType(typ, GeoJSONTypes...))
+			}
+			types = append(types, typ)
+		}
+	}
+	return &GeoJSONValidator{Types: types}
+}
+
+func validateGeoJSON(obj *geoJSONObject) error {
+	switch obj.Type {
+	case "Point":
+		var position []float64
+		if err := unmarshalCoordinates(obj, &position); err != nil {
+			return err
+		}
+		return validatePosition(position)
+	case "MultiPoint":
+		var positions [][]float64
+		if err := unmarshalCoordinates(obj, &positions); err != nil {
+			return err
+		}
+		return validatePositions(positions, 0)
+	case "LineString":
+		var line [][]float64
+		if err := unmarshalCoordinates(obj, &line); err != nil {
+			return err
+		}
+		return validatePositions(line, 2)
+	case "MultiLineString":
+		var lines [][][]float64
+		if err := unmarshalCoordinates(obj, &lines); err != nil {
+			return err
+		}
+		for _, line := range lines {
+			if err := validatePositions(line, 2); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "Polygon":
+		var polygon [][][]float64
+		if err := unmarshalCoordinates(obj, &polygon); err != nil {
+			return err
+		}
+		return validatePolygon(polygon)
+	case "MultiPolygon":
+		var polygons [][][][]float64
+		if err := unmarshalCoordinates(obj, &polygons); err != nil {
+			return err
+		}
+		for _, polygon := range polygons {
+			if err := validatePolygon(polygon); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "GeometryCollection":
+		if obj.Geometries == nil {
+			return ErrGeoJSON("missing geometries")
+		}
+		for _, raw := range obj.Geometries {
+			if err := validateGeoJSONMember(raw, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "Feature":
+		if len(obj.Geometry) == 0 {
+			return ErrGeoJSON("missing geometry")
+		}
+		if !isJSONNullOrObject(obj.Properties) {
+			return ErrGeoJSON("properties should be object or null")
+		}
+		if string(obj.Geometry) == "null" {
+			return nil
+		}
+		return validateGeoJSONMember(obj.Geometry, false)
+	case "FeatureCollection":
+		if obj.Features == nil {
+			return ErrGeoJSON("missing features")
+		}
+		for _, raw := range obj.Features {
+			if err := validateGeoJSONMember(raw, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return ErrGeoJSONType(obj.Type, GeoJSONTypes...)
+}
+
+// validateGeoJSONMember validates the nested geometry, or feature if isFeature is true.
+func validateGeoJSONMember(data json.RawMessage, isFeature bool) error {
+	var obj geoJSONObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return ErrGeoJSON("%v", err)
+	}
+	if isFeature && obj.Type != "Feature" {
+		return ErrGeoJSONType(obj.Type, "Feature")
+	}
+	if !isFeature && (obj.Type == "Feature" || obj.Type == "FeatureCollection") {
+		return ErrGeoJSONType(obj.Type, GeoJSONTypes[:7]...)
+	}
+	return validateGeoJSON(&obj)
+}
+
+func unmarshalCoordinates(obj *geoJSONObject, coordinates interface{}) error {
+	if len(obj.Coordinates) == 0 {
+		return ErrGeoJSON("%v missing coordinates", obj.Type)
+	}
+	if err := json.Unmarshal(obj.Coordinates, coordinates); err != nil {
+		return ErrGeoJSON("%v invalid coordinates", obj.Type)
+	}
+	return nil
+}
+
+func isJSONNullOrObject(data json.RawMessage) bool {
+	str := strings.TrimSpace(string(data))
+	return str == "" || str == "null" || strings.HasPrefix(str, "{")
+}
+
+// validatePosition checks the position is [longitude, latitude] with optional altitude.
+func validatePosition(position []float64) error {
+	if len(position) < 2 || len(position) > 3 {
+		return ErrGeoJSON("position should have 2 or 3 elements, but got %v", len(position))
+	}
+	if !isLongitude(position[0]) {
+		return ErrGeoJSON("invalid longitude %v", position[0])
+	}
+	if !isLatitude(position[1]) {
+		return ErrGeoJSON("invalid latitude %v", position[1])
+	}
+	return nil
+}
+
+func validatePositions(positions [][]float64, min int) error {
+	if len(positions) < min {
+		return ErrGeoJSON("should have at least %v positions, but got %v", min, len(positions))
+	}
+	for _, position := range positions {
+		if err := validatePosition(position); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePolygon checks the rings of polygon are closed and have at least 4 positions.
+func validatePolygon(polygon [][][]float64) error {
+	for _, ring := range polygon {
+		if err := validatePositions(ring, 4); err != nil {
+			return err
+		}
+		first, last := ring[0], ring[len(ring)-1]
+		if len(first) != len(last) {
+			return ErrGeoJSON("linear ring not closed")
+		}
+		for i := range first {
+			if first[i] != last[i] {
+				return ErrGeoJSON("linear ring not closed")
+			}
+		}
+	}
+	return nil
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("bbox", CompileBBox)
+	TagValidatorMap.RegisterCompileFunc("geojson", CompileGeoJSON)
+}
@@ -0,0 +1,206 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoordinateKinds(t *testing.T) {
+	t.Parallel()
+
+	type Degree float64
+
+	var tests = []struct {
+		param     interface{}
+		latitude  bool
+		longitude bool
+	}{
+		{0.0, true, true},
+		{float32(-90), true, true},
+		{90.0, true, true},
+		{90.0001, false, true},
+		{-180.0, false, true},
+		{180.5, false, false},
+		{45, true, true},
+		{uint8(100), false, true},
+		{Degree(31.2), true, true},
+		{"31.2", true, true},
+		{"-120.5", false, true},
+		{"abc", false, false},
+		{"1e2", false, false},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%v)", name, test.param)
+			} else {
+				require.Error(t, err, "check %s(%v)", name, test.param)
+			}
+		}
+		check(IsLatitude(test.param), test.latitude, "latitude")
+		check(IsLongitude(test.param), test.longitude, "longitude")
+	}
+
+	require.Panics(t, func() { _ = IsLatitude(true) })
+}
+
+func TestIsLatLng(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{"31.23,121.47", true},
+		{"31.23, 121.47", true},
+		{"-90,-180", true},
+		{"121.47,31.23", false},
+		{"31.23", false},
+		{"31.23,121.47,0", false},
+		{"a,b", false},
+		{[2]float64{31.23, 121.47}, true},
+		{[]float64{31.23, 121.47}, true},
+		{[]float64{}, true},
+		{[]float64{31.23}, false},
+		{[]float64{121.47, 31.23}, false},
+	}
+	for _, test := range tests {
+		err := IsLatLng(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsLatLng(%v)", test.param)
+		} else {
+			require.Error(t, err, "check IsLatLng(%v)", test.param)
+		}
+	}
+}
+
+func TestIsGeohash(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"wtw3sjq6q", true},
+		{"u4pruydqqvj", true},
+		{"ezs42", true},
+		{"wtw3sjq6qa", false},
+		{"WTW3SJQ6Q", false},
+		{"u4pruydqqvj8u", false},
+		{"abc", false},
+	}
+	for _, test := range tests {
+		err := IsGeohash(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsGeohash(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsGeohash(%s)", test.param)
+		}
+	}
+}
+
+func TestBBox(t *testing.T) {
+	t.Parallel()
+
+	china := CompileBBox("18", "73", "54", "135")
+	pacific := CompileBBox("-60", "150", "60", "-150")
+
+	var tests = []struct {
+		validator Validator
+		param     interface{}
+		expected  bool
+	}{
+		{china, "", true},
+		{china, "31.23,121.47", true},
+		{china, [2]float64{39.9, 116.4}, true},
+		{china, "40.71,-74.00", false},
+		{china, "60,100", false},
+		{pacific, "0,179", true},
+		{pacific, "0,-170", true},
+		{pacific, "0,0", false},
+	}
+	for _, test := range tests {
+		err := test.validator.Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check bbox(%v)", test.param)
+		} else {
+			require.Error(t, err, "check bbox(%v)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompileBBox("1", "2", "3") })
+	require.Panics(t, func() { CompileBBox("a", "0", "1", "1") })
+	require.Panics(t, func() { CompileBBox("10", "0", "-10", "1") })
+}
+
+func TestGeoJSON(t *testing.T) {
+	t.Parallel()
+
+	all := CompileGeoJSON()
+	polygon := CompileGeoJSON("Point|Polygon")
+
+	var tests = []struct {
+		validator Validator
+		param     interface{}
+		expected  bool
+	}{
+		{all, "", true},
+		{all, `{"type":"Point","coordinates":[121.47,31.23]}`, true},
+		{all, `{"type":"Point","coordinates":[121.47,31.23,10]}`, true},
+		{all, `{"type":"Point","coordinates":[31.23,121.47]}`, false},
+		{all, `{"type":"Point","coordinates":[121.47]}`, false},
+		{all, `{"type":"Point"}`, false},
+		{all, `{"type":"LineString","coordinates":[[0,0],[1,1]]}`, true},
+		{all, `{"type":"LineString","coordinates":[[0,0]]}`, false},
+		{all, `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, true},
+		{all, `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,1]]]}`, false},
+		{all, `{"type":"Polygon","coordinates":[[[0,0],[1,0],[0,0]]]}`, false},
+		{all, `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[2,2],[3,2],[3,3],[2,2]]]]}`, true},
+		{all, `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[0,0]}]}`, true},
+		{all, `{"type":"GeometryCollection","geometries":[{"type":"Feature","geometry":null}]}`, false},
+		{all, `{"type":"Feature","geometry":null,"properties":{"name":"a"}}`, true},
+		{all, `{"type":"Feature","geometry":{"type":"Point","coordinates":[0,0]},"properties":1}`, false},
+		{all, `{"type":"FeatureCollection","features":[{"type":"Feature","geometry":{"type":"Point","coordinates":[0,0]},"properties":null}]}`, true},
+		{all, `{"type":"FeatureCollection","features":[{"type":"Point","coordinates":[0,0]}]}`, false},
+		{all, `{"type":"Circle","coordinates":[0,0]}`, false},
+		{all, `not json`, false},
+		{all, []byte(`{"type":"Point","coordinates":[0,0]}`), true},
+		{all, json.RawMessage(`{"type":"Point","coordinates":[0,0]}`), true},
+		{polygon, `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, true},
+		{polygon, `{"type":"LineString","coordinates":[[0,0],[1,1]]}`, false},
+	}
+	for _, test := range tests {
+		err := test.validator.Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check geojson(%s)", test.param)
+		} else {
+			require.Error(t, err, "check geojson(%s)", test.param)
+			require.True(t, errors.Is(err, ErrInvalidGeoJSON), "check geojson(%s)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompileGeoJSON("Circle") })
+}
+
+func TestGeoStruct(t *testing.T) {
+	t.Parallel()
+
+	type Place struct {
+		Lat      float64 `valid:"latitude"`
+		Lng      float64 `valid:"longitude"`
+		Location string  `valid:"latlng;bbox(18,73,54,135)"`
+	}
+
+	require.NoError(t, ValidateStruct(&Place{Lat: 31.23, Lng: 121.47, Location: "31.23,121.47"}))
+
+	err := ValidateStruct(&Place{Lat: 91, Lng: 181, Location: "40.71,-74.00"})
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Len(t, errs, 3)
+	require.True(t, errs.HasCode("bbox"))
+}
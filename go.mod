@@ -1,3 +1,9 @@
 module github.com/stn81/govalidator
 
-go 1.18
+go 1.20
+
+require (
+	github.com/rivo/uniseg v0.4.7
+	golang.org/x/net v0.18.0
+	golang.org/x/text v0.14.0
+)
@@ -0,0 +1,187 @@
+package govalidator
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+const (
+	maxHostnameLength = 253
+	maxLabelLength    = 63
+)
+
+var (
+	ErrInvalidHostname = errors.New("invalid hostname")
+	ErrInvalidFQDN     = errors.New("invalid FQDN")
+	ErrInvalidDNSLabel = errors.New("invalid DNS label")
+	ErrInvalidDomain   = errors.New("invalid domain")
+	ErrInvalidHostPort = errors.New("invalid host:port")
+)
+
+// isDNSLabel checks the RFC 1123 label: letters, digits and hyphens, not start or end with hyphen.
+func isDNSLabel(label string) bool {
+	if len(label) == 0 || len(label) > maxLabelLength {
+		return false
+	}
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func isHostname(str string) bool {
+	if len(str) == 0 || len(str) > maxHostnameLength {
+		return false
+	}
+	for _, label := range strings.Split(str, ".") {
+		if !isDNSLabel(label) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFQDN(str string) bool {
+	str = strings.TrimSuffix(str, ".")
+	if !isHostname(str) {
+		return false
+	}
+	p := strings.LastIndexByte(str, '.')
+	if p == -1 {
+		return false
+	}
+
+	// the top level domain is not all numeric
+	tld := str[p+1:]
+	for i := 0; i < len(tld); i++ {
+		if tld[i] < '0' || tld[i] > '9' {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHostname check if the string is a RFC 1123 hostname. Empty string is valid.
+func IsHostname(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if isHostname(str) {
+		return nil
+	}
+	return ErrInvalidHostname
+}
+
+// IsFQDN check if the string is a fully qualified domain name, the trailing dot is optional.
+// Empty string is valid.
+func IsFQDN(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if isFQDN(str) {
+		return nil
+	}
+	return ErrInvalidFQDN
+}
+
+// IsDNSLabel check if the string is a single RFC 1123 DNS label. Empty string is valid.
+func IsDNSLabel(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if isDNSLabel(str) {
+		return nil
+	}
+	return ErrInvalidDNSLabel
+}
+
+// IsDomain check if the string is a domain name, the `xn--` punycode labels should be valid IDNA2008 labels.
+// With `domain(idna)`, the internationalized domain names are converted to punycode before checking.
+// Empty string is valid.
+func IsDomain(value interface{}, args ...string) error {
+	return CompileDomain(args...).Validate(value)
+}
+
+// DomainValidator checks the string is a domain name, see IsDomain.
+type DomainValidator struct {
+	IDNA bool
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *DomainValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if v.IDNA {
+		ascii, ok := toASCIIDomain(str)
+		if !ok {
+			return ErrInvalidDomain
+		}
+		str = ascii
+	}
+
+	if strings.HasSuffix(str, ".") || !isFQDN(str) || !isPunycodeValid(str) {
+		return ErrInvalidDomain
+	}
+	return nil
+}
+
+// CompileDomain creates the validator of tag `domain` or `domain(idna)`.
+func CompileDomain(args ...string) Validator {
+	v := &DomainValidator{}
+	for _, arg := range args {
+		switch strings.TrimSpace(arg) {
+		case "idna":
+			v.IDNA = true
+		default:
+			panic(ErrUnknownOption("domain", arg))
+		}
+	}
+	return v
+}
+
+// IsHostPort check if the string is host:port, the host is a hostname, an IPv4 or a bracketed IPv6.
+// Empty string is valid.
+func IsHostPort(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	host, port, err := net.SplitHostPort(str)
+	if err != nil || port == "" || IsPort(port) != nil {
+		return ErrInvalidHostPort
+	}
+
+	// the brackets are only allowed around, and required by, the IPv6 literals
+	bracketed := strings.HasPrefix(str, "[")
+	if bracketed || strings.Contains(host, ":") {
+		if bracketed && strings.Contains(host, ":") && net.ParseIP(host) != nil {
+			return nil
+		}
+		return ErrInvalidHostPort
+	}
+	if net.ParseIP(host) != nil || isHostname(host) {
+		return nil
+	}
+	return ErrInvalidHostPort
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("domain", CompileDomain)
+}
@@ -0,0 +1,169 @@
+package govalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToASCIIDomain(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		domain   string
+		expected string
+		ok       bool
+	}{
+		{"example.com", "example.com", true},
+		{"B\u00fccher.example.com", "xn--bcher-kva.example.com", true},
+		{"\u4f8b\u5b50\u3002\u4e2d\u56fd", "xn--fsqu00a.xn--fiqs8s", true},
+		{"\u0440\u0444", "xn--p1ai", true},
+		{"\u2603.com", "", false},
+		{"a\u200db.com", "", false},
+	}
+	for _, test := range tests {
+		ascii, ok := toASCIIDomain(test.domain)
+		require.Equal(t, test.ok, ok, "check toASCIIDomain(%s)", test.domain)
+		if test.ok {
+			require.Equal(t, test.expected, ascii, "check toASCIIDomain(%s)", test.domain)
+		}
+	}
+}
+
+func TestIsHostname(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"localhost", true},
+		{"example.com", true},
+		{"a-b.example.com", true},
+		{"1.2.3.4", true},
+		{"123abc", true},
+		{"-abc.com", false},
+		{"abc-.com", false},
+		{"ab_c.com", false},
+		{"example..com", false},
+		{"example.com.", false},
+		{"exa mple.com", false},
+		{string(make([]byte, 64)), false},
+	}
+	for _, test := range tests {
+		err := IsHostname(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsHostname(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsHostname(%s)", test.param)
+		}
+	}
+}
+
+func TestIsFQDN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"example.com", true},
+		{"example.com.", true},
+		{"www.example.co.uk", true},
+		{"localhost", false},
+		{"1.2.3.4", false},
+		{"example.c0m", true},
+		{"example..com", false},
+		{".example.com", false},
+	}
+	for _, test := range tests {
+		err := IsFQDN(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsFQDN(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsFQDN(%s)", test.param)
+		}
+	}
+}
+
+func TestIsDNSLabel(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, IsDNSLabel("my-service"))
+	require.NoError(t, IsDNSLabel(""))
+	require.Error(t, IsDNSLabel("my.service"))
+	require.Error(t, IsDNSLabel("-service"))
+}
+
+func TestIsDomain(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		args     []string
+		expected bool
+	}{
+		{"", nil, true},
+		{"example.com", nil, true},
+		{"xn--bcher-kva.example.com", nil, true},
+		{"xn--fiqs8s", nil, false},
+		{"example.xn--fiqs8s", nil, true},
+		{"xn--a!.example.com", nil, false},
+		{"example.com.", nil, false},
+		{"bücher.example.com", nil, false},
+		{"bücher.example.com", []string{"idna"}, true},
+		{"例え.中国", []string{"idna"}, true},
+		{"\u4f8b\u5b50\u3002\u4e2d\u56fd", []string{"idna"}, true},
+		{"\u2603.com", []string{"idna"}, false},
+		{"xn--n3h.com", []string{"idna"}, false},
+		{"xn--n3h.com", nil, false},
+		{"a\u200db.com", []string{"idna"}, false},
+	}
+	for _, test := range tests {
+		err := IsDomain(test.param, test.args...)
+		if test.expected {
+			require.NoError(t, err, "check IsDomain(%s, %v)", test.param, test.args)
+		} else {
+			require.Error(t, err, "check IsDomain(%s, %v)", test.param, test.args)
+		}
+	}
+
+	require.Panics(t, func() { CompileDomain("idnaa") })
+}
+
+func TestIsHostPort(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"localhost:8080", true},
+		{"example.com:443", true},
+		{"127.0.0.1:80", true},
+		{"[::1]:80", true},
+		{"[2001:db8::1]:65535", true},
+		{"::1:80", false},
+		{"[::1]", false},
+		{"localhost", false},
+		{"localhost:", false},
+		{"localhost:0", false},
+		{"localhost:65536", false},
+		{"local_host:80", false},
+		{"[example.com]:80", false},
+		{"[1.2.3.4]:80", false},
+		{"[::ffff:1.2.3.4]:80", true},
+		{"[]:80", false},
+	}
+	for _, test := range tests {
+		err := IsHostPort(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsHostPort(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsHostPort(%s)", test.param)
+		}
+	}
+}
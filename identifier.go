@@ -0,0 +1,263 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	base62          = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	maxKSUID        = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+var (
+	ErrInvalidUUID        = errors.New("invalid UUID")
+	ErrInvalidUUIDVariant = errors.New("invalid UUID variant")
+	ErrZeroUUID           = errors.New("zero UUID")
+	ErrInvalidULID        = errors.New("invalid ULID")
+	ErrInvalidKSUID       = errors.New("invalid KSUID")
+	ErrInvalidObjectID    = errors.New("invalid ObjectID")
+	ErrInvalidSnowflake   = errors.New("invalid snowflake ID")
+	ErrZeroID             = errors.New("zero ID")
+)
+
+func ErrInvalidUUIDVersion(got int, expected ...string) error {
+	return fmt.Errorf("invalid UUID version %v, should be one of [%v]", got, strings.Join(expected, ","))
+}
+
+// IsUUID check if the value is a RFC 4122 UUID, args are the allowed versions, e.g. `uuid(4)` or `uuid(3|4|5|7)`.
+// The string form is the canonical 8-4-4-4-12 hex, [16]byte arrays should not be zero.
+// Empty string is valid.
+func IsUUID(value interface{}, args ...string) error {
+	var b []byte
+	val := reflect.ValueOf(value)
+	switch {
+	case val.Kind() == reflect.String:
+		str := val.String()
+		if str == "" {
+			return nil
+		}
+		var ok bool
+		if b, ok = parseUUID(str); !ok {
+			return ErrInvalidUUID
+		}
+	case !val.IsValid():
+		return nil
+	case isByteArray(val, 16):
+		b = byteArrayOf(val)
+		if isZeroBytes(b) {
+			return ErrZeroUUID
+		}
+	default:
+		return ErrUnsupportedType(val.Type())
+	}
+
+	if b[8]&0xc0 != 0x80 {
+		return ErrInvalidUUIDVariant
+	}
+
+	version := int(b[6] >> 4)
+	if len(args) == 0 {
+		if version < 1 || version > 8 {
+			return ErrInvalidUUIDVersion(version, "1", "2", "3", "4", "5", "6", "7", "8")
+		}
+		return nil
+	}
+
+	var versions []string
+	for _, arg := range args {
+		versions = append(versions, split(arg, "|")...)
+	}
+	for _, v := range versions {
+		if GetInt(v) == version {
+			return nil
+		}
+	}
+	return ErrInvalidUUIDVersion(version, versions...)
+}
+
+func parseUUID(str string) ([]byte, bool) {
+	if len(str) != 36 || str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+		return nil, false
+	}
+	hex := str[0:8] + str[9:13] + str[14:18] + str[19:23] + str[24:36]
+	b := make([]byte, 16)
+	for i := 0; i < 16; i++ {
+		hi, ok1 := fromHexChar(hex[2*i])
+		lo, ok2 := fromHexChar(hex[2*i+1])
+		if !ok1 || !ok2 {
+			return nil, false
+		}
+		b[i] = hi<<4 | lo
+	}
+	return b, true
+}
+
+func fromHexChar(c byte) (byte, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0', true
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10, true
+	case 'A' <= c && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+// IsULID check if the value is an ULID, [16]byte arrays should not be zero. Empty string is valid.
+func IsULID(value interface{}, args ...string) error {
+	val := reflect.ValueOf(value)
+	switch {
+	case val.Kind() == reflect.String:
+		str := val.String()
+		if str == "" {
+			return nil
+		}
+		if len(str) != 26 || str[0] > '7' {
+			return ErrInvalidULID
+		}
+		for i := 0; i < len(str); i++ {
+			if strings.IndexByte(crockfordBase32, upper(str[i])) == -1 {
+				return ErrInvalidULID
+			}
+		}
+		return nil
+	case !val.IsValid():
+		return nil
+	case isByteArray(val, 16):
+		if isZeroBytes(byteArrayOf(val)) {
+			return ErrZeroID
+		}
+		return nil
+	}
+	return ErrUnsupportedType(val.Type())
+}
+
+// IsKSUID check if the value is a KSUID, [20]byte arrays should not be zero. Empty string is valid.
+func IsKSUID(value interface{}, args ...string) error {
+	val := reflect.ValueOf(value)
+	switch {
+	case val.Kind() == reflect.String:
+		str := val.String()
+		if str == "" {
+			return nil
+		}
+		if len(str) != len(maxKSUID) {
+			return ErrInvalidKSUID
+		}
+		for i := 0; i < len(str); i++ {
+			if strings.IndexByte(base62, str[i]) == -1 {
+				return ErrInvalidKSUID
+			}
+		}
+		// the base62 alphabet is in ASCII order, so the strings of same length compare as numbers
+		if str > maxKSUID {
+			return ErrInvalidKSUID
+		}
+		return nil
+	case !val.IsValid():
+		return nil
+	case isByteArray(val, 20):
+		if isZeroBytes(byteArrayOf(val)) {
+			return ErrZeroID
+		}
+		return nil
+	}
+	return ErrUnsupportedType(val.Type())
+}
+
+// IsObjectID check if the value is a MongoDB ObjectID in hex, [12]byte arrays should not be zero.
+// Empty string is valid.
+func IsObjectID(value interface{}, args ...string) error {
+	val := reflect.ValueOf(value)
+	switch {
+	case val.Kind() == reflect.String:
+		str := val.String()
+		if str == "" {
+			return nil
+		}
+		if len(str) != 24 {
+			return ErrInvalidObjectID
+		}
+		for i := 0; i < len(str); i++ {
+			if _, ok := fromHexChar(str[i]); !ok {
+				return ErrInvalidObjectID
+			}
+		}
+		return nil
+	case !val.IsValid():
+		return nil
+	case isByteArray(val, 12):
+		if isZeroBytes(byteArrayOf(val)) {
+			return ErrZeroID
+		}
+		return nil
+	}
+	return ErrUnsupportedType(val.Type())
+}
+
+// IsSnowflake check if the value is a snowflake ID, a positive 63 bits integer.
+// The string form is decimal without leading zeros. Empty string is valid.
+func IsSnowflake(value interface{}, args ...string) error {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.String:
+		str := val.String()
+		if str == "" {
+			return nil
+		}
+		if str[0] < '1' || str[0] > '9' {
+			return ErrInvalidSnowflake
+		}
+		if _, err := strconv.ParseInt(str, 10, 64); err != nil {
+			return ErrInvalidSnowflake
+		}
+		return nil
+	case reflect.Int, reflect.Int64:
+		if val.Int() > 0 {
+			return nil
+		}
+		return ErrInvalidSnowflake
+	case reflect.Uint, reflect.Uint64:
+		if val.Uint() > 0 && val.Uint() <= math.MaxInt64 {
+			return nil
+		}
+		return ErrInvalidSnowflake
+	case reflect.Invalid:
+		return nil
+	}
+	return ErrUnsupportedType(val.Type())
+}
+
+func upper(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+// isByteArray checks if val is a byte array of size, e.g. uuid.UUID.
+func isByteArray(val reflect.Value, size int) bool {
+	return val.Kind() == reflect.Array && val.Len() == size && val.Type().Elem().Kind() == reflect.Uint8
+}
+
+func byteArrayOf(val reflect.Value) []byte {
+	b := make([]byte, val.Len())
+	reflect.Copy(reflect.ValueOf(b), val)
+	return b
+}
+
+func isZeroBytes(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,180 @@
+package govalidator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testUUID [16]byte
+
+func TestIsUUID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		args     []string
+		expected bool
+	}{
+		{"", nil, true},
+		{nil, nil, true},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", nil, true},
+		{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", nil, true},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", nil, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", nil, true},
+		{"00000000-0000-0000-0000-000000000000", nil, false},
+		{"f47ac10b-58cc-4372-c567-0e02b2c3d479", nil, false},
+		{"f47ac10b-58cc-0372-a567-0e02b2c3d479", nil, false},
+		{"f47ac10b58cc4372a5670e02b2c3d479", nil, false},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d47g", nil, false},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", []string{"4"}, true},
+		{"f47ac10b-58cc-4372-a567-0e02b2c3d479", []string{"3|5"}, false},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", []string{"3|4|5|7"}, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", []string{"4", "7"}, true},
+		{testUUID{}, nil, false},
+		{testUUID{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xa5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}, []string{"4"}, true},
+		{[16]byte{0xf4, 0x7a, 0xc1, 0x0b, 0x58, 0xcc, 0x43, 0x72, 0xc5, 0x67, 0x0e, 0x02, 0xb2, 0xc3, 0xd4, 0x79}, nil, false},
+		{123, nil, false},
+	}
+	for _, test := range tests {
+		err := IsUUID(test.param, test.args...)
+		if test.expected {
+			require.NoError(t, err, "check IsUUID(%v, %v)", test.param, test.args)
+		} else {
+			require.Error(t, err, "check IsUUID(%v, %v)", test.param, test.args)
+		}
+	}
+
+	require.True(t, errors.Is(IsUUID(testUUID{}), ErrZeroUUID))
+	require.True(t, errors.Is(IsUUID("f47ac10b-58cc-4372-c567-0e02b2c3d479"), ErrInvalidUUIDVariant))
+}
+
+func TestIsULID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{nil, true},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+		{"01arz3ndektsv4rrffq69g5fav", true},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", true},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", false},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAU", false},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FA", false},
+		{[16]byte{}, false},
+		{[16]byte{1}, true},
+	}
+	for _, test := range tests {
+		err := IsULID(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsULID(%v)", test.param)
+		} else {
+			require.Error(t, err, "check IsULID(%v)", test.param)
+		}
+	}
+}
+
+func TestIsKSUID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{nil, true},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", true},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80V", true},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80W", false},
+		{"zzzzzzzzzzzzzzzzzzzzzzzzzzz", false},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO-", false},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO", false},
+		{[20]byte{}, false},
+		{[20]byte{1}, true},
+	}
+	for _, test := range tests {
+		err := IsKSUID(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsKSUID(%v)", test.param)
+		} else {
+			require.Error(t, err, "check IsKSUID(%v)", test.param)
+		}
+	}
+}
+
+func TestIsObjectID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{nil, true},
+		{"507f1f77bcf86cd799439011", true},
+		{"507F1F77BCF86CD799439011", true},
+		{"507f1f77bcf86cd79943901", false},
+		{"507f1f77bcf86cd79943901z", false},
+		{[12]byte{}, false},
+		{[12]byte{1}, true},
+	}
+	for _, test := range tests {
+		err := IsObjectID(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsObjectID(%v)", test.param)
+		} else {
+			require.Error(t, err, "check IsObjectID(%v)", test.param)
+		}
+	}
+}
+
+func TestIsSnowflake(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{nil, true},
+		{"1541815603606036480", true},
+		{"9223372036854775807", true},
+		{"9223372036854775808", false},
+		{"01541815603606036480", false},
+		{"-1", false},
+		{"12a", false},
+		{int64(1541815603606036480), true},
+		{int64(0), false},
+		{int64(-1), false},
+		{uint64(1541815603606036480), true},
+		{uint64(1 << 63), false},
+	}
+	for _, test := range tests {
+		err := IsSnowflake(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsSnowflake(%v)", test.param)
+		} else {
+			require.Error(t, err, "check IsSnowflake(%v)", test.param)
+		}
+	}
+}
+
+type stIdentifier struct {
+	ID      string   `json:"id" valid:"required;uuid(4)"`
+	TraceID testUUID `json:"trace_id" valid:"uuid"`
+	OrderID int64    `json:"order_id" valid:"snowflake"`
+}
+
+func TestIdentifierTags(t *testing.T) {
+	st := &stIdentifier{ID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Equal(t, []string{"id", "trace_id", "order_id"}, errs.Fields())
+	require.Equal(t, "uuid", errs.FindByName("id").Code)
+	require.Equal(t, []string{"4"}, errs.FindByName("id").Params)
+}
@@ -0,0 +1,72 @@
+package govalidator
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// acePrefix is the prefix of the punycode labels.
+const acePrefix = "xn--"
+
+// idnaExceptions are the runes allowed in the labels besides the letters, marks and digits,
+// the PVALID, CONTEXTJ and CONTEXTO exceptions of RFC 5892. The joiners are checked by idna.
+var idnaExceptions = map[rune]bool{
+	'-':      true,
+	'\u00b7': true, // middle dot
+	'\u0375': true, // greek lower numeral sign
+	'\u05f3': true, // hebrew geresh
+	'\u05f4': true, // hebrew gershayim
+	'\u06fd': true, // arabic sign sindhi ampersand
+	'\u06fe': true, // arabic sign sindhi postposition men
+	'\u0f0b': true, // tibetan mark intersyllabic tsheg
+	'\u200c': true, // zero width non-joiner
+	'\u200d': true, // zero width joiner
+	'\u3007': true, // ideographic number zero
+	'\u30fb': true, // katakana middle dot
+}
+
+// toASCIIDomain converts the internationalized domain name to punycode by the IDNA lookup
+// profile, which maps the name like UTS #46, e.g. the ideographic full stop to dot, and checks
+// the labels. The ASCII name is returned as is. The ok is false if the name is not a valid IDN.
+func toASCIIDomain(domain string) (string, bool) {
+	if isASCII(domain) {
+		return domain, true
+	}
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil || !isPunycodeValid(ascii) {
+		return domain, false
+	}
+	return ascii, true
+}
+
+// isPunycodeValid checks the `xn--` labels are valid IDNA2008 labels. UTS #46 allows the
+// symbols like U+2603, but IDNA2008 only allows the letters, marks, digits and exceptions.
+func isPunycodeValid(domain string) bool {
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) <= len(acePrefix) || !strings.EqualFold(label[:len(acePrefix)], acePrefix) {
+			continue
+		}
+		decoded, err := idna.Lookup.ToUnicode(label)
+		if err != nil || isASCII(decoded) {
+			return false
+		}
+		for _, r := range decoded {
+			if !unicode.In(r, unicode.L, unicode.Mn, unicode.Mc, unicode.Nd) && !idnaExceptions[r] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isASCII(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
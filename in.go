@@ -0,0 +1,180 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// OptionCaseInsensitive is the option of the tags with the fixed arguments, which compares the
+// string case-insensitively, e.g. `hash(sha256,ci)`, `iso4217(ci)`. The tags of value list have
+// the `_ci` variants instead, e.g. `in_ci(a,b)`, `not_in_ci(a,b)`.
+const OptionCaseInsensitive = "ci"
+
+func ErrInList(value interface{}, args ...string) error {
+	return fmt.Errorf("%v should not be in list: [%v]", value, strings.Join(args, ","))
+}
+
+func ErrUnknownOption(funcName string, option string) error {
+	return fmt.Errorf("function %v unknown option: %v", funcName, option)
+}
+
+// parseCaseOption parses the options of tag, only `ci` is allowed.
+func parseCaseOption(funcName string, options []string) (caseInsensitive bool) {
+	for _, option := range options {
+		switch strings.TrimSpace(option) {
+		case OptionCaseInsensitive:
+			caseInsensitive = true
+		default:
+			panic(ErrUnknownOption(funcName, option))
+		}
+	}
+	return caseInsensitive
+}
+
+// InValidator checks the string form of value is in the Values, or not in the Values if Not is true.
+// The values are kept in a set, so the long lists are checked in constant time.
+type InValidator struct {
+	Values          []string
+	Not             bool
+	CaseInsensitive bool
+	set             stringSet
+}
+
+// NewInValidator creates the InValidator of the values.
+func NewInValidator(not, caseInsensitive bool, values ...string) *InValidator {
+	v := &InValidator{
+		Values:          values,
+		Not:             not,
+		CaseInsensitive: caseInsensitive,
+		set:             make(stringSet, len(values)),
+	}
+	for _, value := range values {
+		if caseInsensitive {
+			value = strings.ToLower(value)
+		}
+		v.set[value] = struct{}{}
+	}
+	return v
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *InValidator) Validate(value interface{}, args ...string) error {
+	str := GetString(value)
+	if str == "" {
+		return nil
+	}
+	if v.CaseInsensitive {
+		str = strings.ToLower(str)
+	}
+
+	in := v.set.Contains(str)
+	switch {
+	case v.Not && in:
+		return ErrInList(value, v.Values...)
+	case !v.Not && !in:
+		return ErrNotInList(value, v.Values...)
+	}
+	return nil
+}
+
+// CompileIn creates the validator of tag `in(a,b,c)`.
+func CompileIn(args ...string) Validator {
+	return NewInValidator(false, false, args...)
+}
+
+// CompileInCI creates the validator of tag `in_ci(a,b,c)`.
+func CompileInCI(args ...string) Validator {
+	return NewInValidator(false, true, args...)
+}
+
+// CompileNotIn creates the validator of tag `not_in(a,b,c)`.
+func CompileNotIn(args ...string) Validator {
+	return NewInValidator(true, false, args...)
+}
+
+// CompileNotInCI creates the validator of tag `not_in_ci(a,b,c)`.
+func CompileNotInCI(args ...string) Validator {
+	return NewInValidator(true, true, args...)
+}
+
+// OneOfValidator checks the value is one of the Values. The numbers, including int, uint and float
+// kinds, *big.Int, *big.Float, *big.Rat and json.Number, are compared numerically, so 1 is one of
+// `oneof(1.0,2)`, others are compared by the string form.
+type OneOfValidator struct {
+	Values  []string
+	set     stringSet
+	numbers stringSet
+}
+
+// NewOneOfValidator creates the OneOfValidator of the values.
+func NewOneOfValidator(values ...string) *OneOfValidator {
+	v := &OneOfValidator{
+		Values:  values,
+		set:     newStringSet(values),
+		numbers: make(stringSet, len(values)),
+	}
+	for _, value := range values {
+		if r := parseRat(strings.TrimSpace(value)); r != nil {
+			v.numbers[r.RatString()] = struct{}{}
+		}
+	}
+	return v
+}
+
+// isNumberValue checks the value should be compared numerically.
+func isNumberValue(value interface{}) bool {
+	switch value.(type) {
+	case json.Number, *big.Int, *big.Float, *big.Rat:
+		return true
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// Validate implements the Validator interface. Empty string, empty json.Number and nil big numbers are valid.
+func (v *OneOfValidator) Validate(value interface{}, args ...string) error {
+	if !isNumberValue(value) {
+		str := GetString(value)
+		if str == "" || v.set.Contains(str) {
+			return nil
+		}
+		return ErrNotInList(value, v.Values...)
+	}
+
+	n, ok, err := toNumeric(value)
+	if err != nil {
+		return ErrNotInList(value, v.Values...)
+	}
+	if !ok {
+		return nil
+	}
+	if r := n.toRat(); r != nil && v.numbers.Contains(r.RatString()) {
+		return nil
+	}
+	return ErrNotInList(value, v.Values...)
+}
+
+// CompileOneOf creates the validator of tag `oneof(1,2,3)`.
+func CompileOneOf(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("oneof", 1))
+	}
+	return NewOneOfValidator(args...)
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("in", CompileIn)
+	TagValidatorMap.RegisterCompileFunc("in_ci", CompileInCI)
+	TagValidatorMap.RegisterCompileFunc("not_in", CompileNotIn)
+	TagValidatorMap.RegisterCompileFunc("not_in_ci", CompileNotInCI)
+	TagValidatorMap.RegisterCompileFunc("oneof", CompileOneOf)
+}
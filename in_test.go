@@ -0,0 +1,129 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInValidator(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		validator Validator
+		param     interface{}
+		expected  bool
+	}{
+		{CompileIn("a", "b"), "", true},
+		{CompileIn("a", "b"), "a", true},
+		{CompileIn("a", "b"), "A", false},
+		{CompileIn("1", "2"), 2, true},
+		{CompileIn(), "a", false},
+		{CompileInCI("Active", "Pending"), "ACTIVE", true},
+		{CompileInCI("Active", "Pending"), "pending", true},
+		{CompileInCI("Active", "Pending"), "closed", false},
+		{CompileNotIn("root", "admin"), "", true},
+		{CompileNotIn("root", "admin"), "alice", true},
+		{CompileNotIn("root", "admin"), "Root", true},
+		{CompileNotIn("root", "admin"), "root", false},
+		{CompileNotInCI("root", "admin"), "Root", false},
+		{CompileNotInCI("root", "admin"), "alice", true},
+	}
+	for _, test := range tests {
+		err := test.validator.Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check %v in %v", test.param, test.validator)
+		} else {
+			require.Error(t, err, "check %v in %v", test.param, test.validator)
+		}
+	}
+
+	require.EqualError(t, CompileNotIn("root").Validate("root"), "root should not be in list: [root]")
+}
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	v := CompileOneOf("1", "2.50", "red")
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{"red", true},
+		{"1", true},
+		{"1.0", false},
+		{"blue", false},
+		{1, true},
+		{uint8(1), true},
+		{1.0, true},
+		{float32(2.5), true},
+		{2.5, true},
+		{json.Number("2.5"), true},
+		{json.Number("1e0"), true},
+		{json.Number(""), true},
+		{big.NewInt(1), true},
+		{(*big.Int)(nil), true},
+		{0, false},
+		{3, false},
+		{2.51, false},
+		{json.Number("red"), false},
+	}
+	for _, test := range tests {
+		err := v.Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check oneof(%v)", test.param)
+		} else {
+			require.Error(t, err, "check oneof(%v)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompileOneOf() })
+}
+
+func TestIsHashCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	hash := "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"
+	require.Error(t, IsHash(hash, "sha256"))
+	require.NoError(t, IsHash(hash, "sha256", OptionCaseInsensitive))
+	require.NoError(t, IsHash(hash, "SHA256", OptionCaseInsensitive))
+	require.Error(t, IsHash(hash[1:], "sha256", OptionCaseInsensitive))
+	require.Panics(t, func() { IsHash(hash, "sha256", "upper") })
+}
+
+func TestValidateStructIn(t *testing.T) {
+	t.Parallel()
+
+	require.Contains(t, TagMap, "in")
+	require.IsType(t, CompileFunc(nil), TagValidatorMap.Get("in"))
+
+	type Order struct {
+		Status   string  `valid:"in_ci(paid,shipped)"`
+		User     string  `valid:"not_in(root,admin)"`
+		Priority int     `valid:"oneof(1,2,3)"`
+		Ratio    float64 `valid:"oneof(0.5,1)"`
+		Currency string  `valid:"iso4217(ci)"`
+		Digest   string  `valid:"hash(md5,ci)"`
+	}
+
+	require.NoError(t, ValidateStruct(&Order{
+		Status:   "PAID",
+		User:     "alice",
+		Priority: 2,
+		Ratio:    0.5,
+		Currency: "usd",
+		Digest:   "D41D8CD98F00B204E9800998ECF8427E",
+	}))
+
+	err := ValidateStruct(&Order{Status: "open", User: "root", Priority: 4, Ratio: 0.25, Currency: "usx", Digest: "x"})
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Len(t, errs, 6)
+	require.True(t, errs.HasCode("in_ci"))
+	require.True(t, errs.HasCode("not_in"))
+	require.True(t, errs.HasCode("oneof"))
+}
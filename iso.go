@@ -0,0 +1,198 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata" // the fallback of time zone database if the system one is missing
+
+	"golang.org/x/text/language"
+)
+
+var (
+	ErrInvalidISO3166Alpha2  = errors.New("invalid ISO3166 alpha-2 country code")
+	ErrInvalidISO3166Alpha3  = errors.New("invalid ISO3166 alpha-3 country code")
+	ErrInvalidISO3166Numeric = errors.New("invalid ISO3166 numeric country code")
+	ErrInvalidISO639Alpha2   = errors.New("invalid ISO639-1 language code")
+	ErrInvalidISO639Alpha3   = errors.New("invalid ISO639-2 language code")
+	ErrInvalidISO4217Numeric = errors.New("invalid ISO4217 numeric currency code")
+	ErrInvalidBCP47          = errors.New("invalid BCP47 language tag")
+	ErrInvalidTimezone       = errors.New("invalid timezone")
+)
+
+func ErrBCP47(err error) error {
+	return fmt.Errorf("%w: %v", ErrInvalidBCP47, err)
+}
+
+// stringSet is the set of strings for the code lookups.
+type stringSet map[string]struct{}
+
+func newStringSet(list []string) stringSet {
+	set := make(stringSet, len(list))
+	for _, str := range list {
+		set[str] = struct{}{}
+	}
+	return set
+}
+
+func (s stringSet) Contains(str string) bool {
+	_, ok := s[str]
+	return ok
+}
+
+// The code sets are built from the lists at initialization.
+var (
+	iso4217Codes    = newStringSet(ISO4217List)
+	iso4217Numerics = make(stringSet, len(ISO4217Currencies))
+	iso3166Alpha2   = make(stringSet, len(ISO3166Countries))
+	iso3166Alpha3   = make(stringSet, len(ISO3166Countries))
+	iso3166Numerics = make(stringSet, len(ISO3166Countries))
+	iso639Alpha2    = newStringSet(ISO639Alpha2List)
+	iso639Alpha3    = newStringSet(ISO639Alpha3List)
+	currencyByCode  = make(map[string]Currency, len(ISO4217Currencies))
+)
+
+func init() {
+	for _, currency := range ISO4217Currencies {
+		iso4217Numerics[currency.Numeric] = struct{}{}
+		currencyByCode[currency.Code] = currency
+	}
+	for _, country := range ISO3166Countries {
+		iso3166Alpha2[country.Alpha2] = struct{}{}
+		iso3166Alpha3[country.Alpha3] = struct{}{}
+		iso3166Numerics[country.Numeric] = struct{}{}
+	}
+}
+
+// LookupCurrency returns the ISO 4217 currency of the alphabetic code.
+func LookupCurrency(code string) (Currency, bool) {
+	currency, ok := currencyByCode[code]
+	return currency, ok
+}
+
+// caseNormalizer returns the normalize func of the codes if the option `ci` is set, otherwise nil.
+func caseNormalizer(funcName string, options []string, normalize func(string) string) func(string) string {
+	if parseCaseOption(funcName, options) {
+		return normalize
+	}
+	return nil
+}
+
+// validateCode checks the string is in the code set, the string is normalized before lookup
+// if normalize is not nil. Empty string is valid.
+func validateCode(value interface{}, set stringSet, invalid error, normalize func(string) string) error {
+	str := assertString(value)
+	if str != "" && normalize != nil {
+		str = normalize(str)
+	}
+	if str == "" || set.Contains(str) {
+		return nil
+	}
+	return invalid
+}
+
+// numericCode converts the value to 3 digits numeric code, the value can be string or integer.
+func numericCode(value interface{}) string {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if val.Int() == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%03d", val.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if val.Uint() == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%03d", val.Uint())
+	}
+	return assertString(value)
+}
+
+// IsISO4217 check if string is valid ISO currency code like USD, any case with the option `ci`.
+func IsISO4217(value interface{}, args ...string) error {
+	return validateCode(value, iso4217Codes, ErrInvalidISO4217CurrencyCode, caseNormalizer("iso4217", args, strings.ToUpper))
+}
+
+// IsISO4217Numeric check if string or integer is valid ISO numeric currency code like 840.
+// Empty string and zero are valid.
+func IsISO4217Numeric(value interface{}, args ...string) error {
+	return validateCode(numericCode(value), iso4217Numerics, ErrInvalidISO4217Numeric, nil)
+}
+
+// IsISO3166Alpha2 check if string is valid ISO 3166-1 alpha-2 country code like CN, any case with the option `ci`.
+// Empty string is valid.
+func IsISO3166Alpha2(value interface{}, args ...string) error {
+	return validateCode(value, iso3166Alpha2, ErrInvalidISO3166Alpha2, caseNormalizer("iso3166_alpha2", args, strings.ToUpper))
+}
+
+// IsISO3166Alpha3 check if string is valid ISO 3166-1 alpha-3 country code like CHN, any case with the option `ci`.
+// Empty string is valid.
+func IsISO3166Alpha3(value interface{}, args ...string) error {
+	return validateCode(value, iso3166Alpha3, ErrInvalidISO3166Alpha3, caseNormalizer("iso3166_alpha3", args, strings.ToUpper))
+}
+
+// IsISO3166Numeric check if string or integer is valid ISO 3166-1 numeric country code like 156.
+// Empty string and zero are valid.
+func IsISO3166Numeric(value interface{}, args ...string) error {
+	return validateCode(numericCode(value), iso3166Numerics, ErrInvalidISO3166Numeric, nil)
+}
+
+// IsISO639Alpha2 check if string is valid ISO 639-1 language code like zh, any case with the option `ci`.
+// Empty string is valid.
+func IsISO639Alpha2(value interface{}, args ...string) error {
+	return validateCode(value, iso639Alpha2, ErrInvalidISO639Alpha2, caseNormalizer("iso639_1", args, strings.ToLower))
+}
+
+// IsISO639Alpha3 check if string is valid ISO 639-2 language code like zho or chi, any case with the option `ci`.
+// Empty string is valid.
+func IsISO639Alpha3(value interface{}, args ...string) error {
+	return validateCode(value, iso639Alpha3, ErrInvalidISO639Alpha3, caseNormalizer("iso639_2", args, strings.ToLower))
+}
+
+// IsBCP47 check if string is well-formed BCP 47 language tag with registered subtags like zh-Hans-CN.
+// Empty string is valid.
+func IsBCP47(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	// language.Parse also accepts underscore as separator
+	if strings.Contains(str, "_") {
+		return ErrInvalidBCP47
+	}
+	if _, err := language.Parse(str); err != nil {
+		return ErrBCP47(err)
+	}
+	return nil
+}
+
+// timezones caches the valid time zone names.
+var timezones sync.Map
+
+// IsTimezone check if string is IANA time zone name like Asia/Shanghai, which can be loaded by
+// time.LoadLocation, the embedded time zone database is used if the system one is missing.
+// Empty string is valid.
+func IsTimezone(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if _, ok := timezones.Load(str); ok {
+		return nil
+	}
+	// Local is the system time zone rather than a name in the database
+	if str == "Local" {
+		return ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(str); err != nil {
+		return ErrInvalidTimezone
+	}
+	timezones.Store(str, struct{}{})
+	return nil
+}
@@ -0,0 +1,154 @@
+package govalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestISOCodes(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		validator func(interface{}, ...string) error
+		param     interface{}
+		expected  bool
+	}{
+		{IsISO4217, "CNY", true},
+		{IsISO4217, "VES", true},
+		{IsISO4217, "SLE", true},
+		{IsISO4217, "HRK", false},
+		{IsISO4217, "VEF", false},
+		{IsISO4217, "MRO", false},
+		{IsISO4217Numeric, "840", true},
+		{IsISO4217Numeric, 840, true},
+		{IsISO4217Numeric, 8, true},
+		{IsISO4217Numeric, "", true},
+		{IsISO4217Numeric, 0, true},
+		{IsISO4217Numeric, "123", false},
+		{IsISO3166Alpha2, "", true},
+		{IsISO3166Alpha2, "CN", true},
+		{IsISO3166Alpha2, "XK", false},
+		{IsISO3166Alpha2, "cn", false},
+		{IsISO3166Alpha2, "CHN", false},
+		{IsISO3166Alpha3, "CHN", true},
+		{IsISO3166Alpha3, "USA", true},
+		{IsISO3166Alpha3, "XXX", false},
+		{IsISO3166Numeric, "156", true},
+		{IsISO3166Numeric, 156, true},
+		{IsISO3166Numeric, uint16(4), true},
+		{IsISO3166Numeric, "4", false},
+		{IsISO3166Numeric, 999, false},
+		{IsISO639Alpha2, "zh", true},
+		{IsISO639Alpha2, "en", true},
+		{IsISO639Alpha2, "zz", false},
+		{IsISO639Alpha2, "ZH", false},
+		{IsISO639Alpha3, "zho", true},
+		{IsISO639Alpha3, "chi", true},
+		{IsISO639Alpha3, "eng", true},
+		{IsISO639Alpha3, "xyz", false},
+	}
+	for _, test := range tests {
+		err := test.validator(test.param)
+		if test.expected {
+			require.NoError(t, err, "check %v", test.param)
+		} else {
+			require.Error(t, err, "check %v", test.param)
+		}
+	}
+
+	currency, ok := LookupCurrency("JPY")
+	require.True(t, ok)
+	require.Equal(t, Currency{"JPY", "392", 0}, currency)
+	currency, ok = LookupCurrency("XAU")
+	require.True(t, ok)
+	require.Equal(t, -1, currency.MinorUnits)
+	require.Len(t, ISO3166Countries, 249)
+}
+
+func TestISOCodesCaseInsensitive(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		validator func(interface{}, ...string) error
+		param     string
+		expected  bool
+	}{
+		{IsISO4217, "cny", true},
+		{IsISO4217, "Usd", true},
+		{IsISO4217, "hrk", false},
+		{IsISO3166Alpha2, "cn", true},
+		{IsISO3166Alpha2, "xk", false},
+		{IsISO3166Alpha3, "chn", true},
+		{IsISO639Alpha2, "ZH", true},
+		{IsISO639Alpha3, "Eng", true},
+		{IsISO639Alpha3, "XYZ", false},
+	}
+	for _, test := range tests {
+		err := test.validator(test.param, OptionCaseInsensitive)
+		if test.expected {
+			require.NoError(t, err, "check %v", test.param)
+		} else {
+			require.Error(t, err, "check %v", test.param)
+		}
+	}
+
+	require.Panics(t, func() { IsISO4217("usd", "fold") })
+}
+
+func TestIsBCP47(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"en", true},
+		{"en-US", true},
+		{"zh-Hans-CN", true},
+		{"sr-Latn-RS", true},
+		{"de-CH-1996", true},
+		{"en-US-x-twain", true},
+		{"en_US", false},
+		{"en-", false},
+		{"toolonglanguage", false},
+		{"en-US-", false},
+	}
+	for _, test := range tests {
+		err := IsBCP47(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsBCP47(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsBCP47(%s)", test.param)
+		}
+	}
+}
+
+func TestIsTimezone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"UTC", true},
+		{"Asia/Shanghai", true},
+		{"America/New_York", true},
+		{"Europe/Kyiv", true},
+		{"Asia/Shanghai", true},
+		{"Local", false},
+		{"Mars/Olympus", false},
+		{"../etc/passwd", false},
+		{"/etc/localtime", false},
+	}
+	for _, test := range tests {
+		err := IsTimezone(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsTimezone(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsTimezone(%s)", test.param)
+		}
+	}
+}
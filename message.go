@@ -0,0 +1,130 @@
+package govalidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MessageCatalog holds the message templates referenced by `~@key` in the tags,
+// register the translated templates to localize the messages. The keys must be registered
+// before the struct types using them are validated, the unknown keys panic.
+var MessageCatalog = &messageCatalog{}
+
+type messageCatalog struct {
+	store sync.Map
+}
+
+// Get returns the message template of key.
+func (c *messageCatalog) Get(key string) (string, bool) {
+	value, ok := c.store.Load(key)
+	if !ok {
+		return "", false
+	}
+	return value.(string), true
+}
+
+// Register registers the message template of key.
+func (c *messageCatalog) Register(key, template string) {
+	c.store.Store(key, template)
+}
+
+// RegisterMessages registers the message templates keyed by the message keys.
+func (c *messageCatalog) RegisterMessages(messages map[string]string) {
+	for key, template := range messages {
+		c.Register(key, template)
+	}
+}
+
+// ErrorContext describes a failed tag validation.
+type ErrorContext struct {
+	Field string
+	Tag   string
+	Args  []string
+	Value interface{}
+	Err   error
+}
+
+// ErrorFactory creates the error reported for a failed tag validation.
+type ErrorFactory func(ctx *ErrorContext) error
+
+// CustomError is a custom error message which wraps the error returned by the validator.
+type CustomError struct {
+	Message string
+	Custom  error
+	Err     error
+}
+
+func (e *CustomError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the custom error, if any, and the error returned by the validator.
+func (e *CustomError) Unwrap() []error {
+	if e.Custom != nil {
+		return []error{e.Custom, e.Err}
+	}
+	return []error{e.Err}
+}
+
+// RenderMessage renders the message template with the error context.
+// The supported placeholders are {field}, {tag}, {value}, {args}, {err}, the positional
+// arguments {0}, {1}, ... and the argument names registered by RegisterParamNames, e.g. {min}.
+func RenderMessage(template string, ctx *ErrorContext) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(template, '{')
+		if start == -1 {
+			break
+		}
+		end := strings.IndexByte(template[start:], '}')
+		if end == -1 {
+			break
+		}
+		end += start
+
+		b.WriteString(template[:start])
+		name := template[start+1 : end]
+		if value, ok := ctx.placeholder(name); ok {
+			b.WriteString(value)
+		} else {
+			b.WriteString(template[start : end+1])
+		}
+		template = template[end+1:]
+	}
+	b.WriteString(template)
+	return b.String()
+}
+
+func (ctx *ErrorContext) placeholder(name string) (string, bool) {
+	switch name {
+	case "field":
+		return ctx.Field, true
+	case "tag":
+		return ctx.Tag, true
+	case "value":
+		return fmt.Sprint(ctx.Value), true
+	case "args":
+		return strings.Join(ctx.Args, ","), true
+	case "err":
+		if ctx.Err == nil {
+			return "", true
+		}
+		return ctx.Err.Error(), true
+	}
+
+	if i, err := strconv.Atoi(name); err == nil {
+		if i >= 0 && i < len(ctx.Args) {
+			return ctx.Args[i], true
+		}
+		return "", false
+	}
+
+	for i, param := range TagValidatorMap.GetParamNames(ctx.Tag) {
+		if param == name && i < len(ctx.Args) {
+			return ctx.Args[i], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,284 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+	"strings"
+)
+
+var (
+	ErrNotPublicIP   = errors.New("not public IP")
+	ErrNotPrivateIP  = errors.New("not private IP")
+	ErrNotLoopbackIP = errors.New("not loopback IP")
+	ErrInvalidCIDRv4 = errors.New("invalid CIDR v4")
+	ErrInvalidCIDRv6 = errors.New("invalid CIDR v6")
+)
+
+func ErrIPNotInRange(value interface{}, ranges []string) error {
+	return fmt.Errorf("%v not in range: [%v]", value, strings.Join(ranges, ","))
+}
+
+func ErrIPInRange(value interface{}, ranges []string) error {
+	return fmt.Errorf("%v should not in range: [%v]", value, strings.Join(ranges, ","))
+}
+
+// nonPublicPrefixes are the special purpose ranges which are not globally reachable, besides
+// the private, loopback, link local, multicast and unspecified addresses. The IPv6 ranges which
+// embed IPv4 addresses (IPv4-compatible, NAT64, 6to4 and Teredo) are rejected as a whole, so
+// the embedded loopback or private addresses can not bypass the check.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("::/96"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// isTypedIP checks if value is a net.IP, netip.Addr or netip.Prefix.
+func isTypedIP(value interface{}) bool {
+	switch value.(type) {
+	case net.IP, netip.Addr, netip.Prefix:
+		return true
+	}
+	return false
+}
+
+// parseAddr converts value to netip.Addr, supporting string, net.IP and netip.Addr.
+// The empty value returns the zero Addr without error.
+func parseAddr(value interface{}) (netip.Addr, error) {
+	switch v := value.(type) {
+	case netip.Addr:
+		return v, nil
+	case net.IP:
+		if len(v) == 0 {
+			return netip.Addr{}, nil
+		}
+		addr, ok := netip.AddrFromSlice(v)
+		if !ok {
+			return netip.Addr{}, ErrInvalidIP
+		}
+		// keep the IPv4 form of the 16 bytes net.IP
+		return addr.Unmap(), nil
+	}
+
+	val := reflect.ValueOf(value)
+	if val.Kind() != reflect.String {
+		return netip.Addr{}, ErrUnsupportedType(val.Type())
+	}
+	if val.String() == "" {
+		return netip.Addr{}, nil
+	}
+	addr, err := netip.ParseAddr(val.String())
+	if err != nil {
+		return netip.Addr{}, ErrInvalidIP
+	}
+	return addr, nil
+}
+
+// parsePrefix converts value to netip.Prefix, supporting string and netip.Prefix.
+// The empty value returns the zero Prefix without error.
+func parsePrefix(value interface{}) (netip.Prefix, error) {
+	if v, ok := value.(netip.Prefix); ok {
+		return v, nil
+	}
+
+	val := reflect.ValueOf(value)
+	if val.Kind() != reflect.String {
+		return netip.Prefix{}, ErrUnsupportedType(val.Type())
+	}
+	if val.String() == "" {
+		return netip.Prefix{}, nil
+	}
+	prefix, err := netip.ParsePrefix(val.String())
+	if err != nil {
+		return netip.Prefix{}, ErrInvalidCIDR
+	}
+	return prefix, nil
+}
+
+// validateTypedIP validates the net.IP, netip.Addr and netip.Prefix values for IsIP, IsIPv4 and IsIPv6.
+func validateTypedIP(value interface{}, is func(netip.Addr) bool, invalid error) error {
+	if prefix, ok := value.(netip.Prefix); ok {
+		if prefix == (netip.Prefix{}) {
+			return nil
+		}
+		if !prefix.IsValid() {
+			return invalid
+		}
+		value = prefix.Addr()
+	}
+
+	addr, err := parseAddr(value)
+	if err != nil {
+		return invalid
+	}
+	if !addr.IsValid() || is(addr) {
+		return nil
+	}
+	return invalid
+}
+
+// IPRangeValidator checks the IP is in, or not in if Not is true, any of the prefixes.
+type IPRangeValidator struct {
+	Prefixes []netip.Prefix
+	Ranges   []string
+	Not      bool
+}
+
+// NewIPRangeValidator creates the IP range validator of the CIDRs or IPs.
+func NewIPRangeValidator(not bool, ranges ...string) *IPRangeValidator {
+	v := &IPRangeValidator{
+		Ranges: ranges,
+		Not:    not,
+	}
+	for _, r := range ranges {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(r)
+			if addrErr != nil {
+				panic(ErrInvalidTag(r, err))
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		v.Prefixes = append(v.Prefixes, prefix.Masked())
+	}
+	return v
+}
+
+// Validate implements the Validator interface, netip.Prefix values should be inside the ranges.
+// Empty value is valid.
+func (v *IPRangeValidator) Validate(value interface{}, args ...string) error {
+	bits := -1
+	if prefix, ok := value.(netip.Prefix); ok {
+		if prefix == (netip.Prefix{}) {
+			return nil
+		}
+		value, bits = prefix.Addr(), prefix.Bits()
+	}
+
+	addr, err := parseAddr(value)
+	if err != nil {
+		return err
+	}
+	if !addr.IsValid() {
+		return nil
+	}
+	addr = addr.Unmap()
+
+	in := false
+	for _, prefix := range v.Prefixes {
+		if prefix.Contains(addr) && (bits == -1 || bits >= prefix.Bits()) {
+			in = true
+			break
+		}
+	}
+
+	switch {
+	case v.Not && in:
+		return ErrIPInRange(value, v.Ranges)
+	case !v.Not && !in:
+		return ErrIPNotInRange(value, v.Ranges)
+	}
+	return nil
+}
+
+// CompileIPIn creates the validator of tag `ip_in(10.0.0.0/8,192.168.1.1)`.
+func CompileIPIn(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("ip_in", 1))
+	}
+	return NewIPRangeValidator(false, args...)
+}
+
+// CompileIPNotIn creates the validator of tag `ip_not_in(10.0.0.0/8,192.168.1.1)`.
+func CompileIPNotIn(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("ip_not_in", 1))
+	}
+	return NewIPRangeValidator(true, args...)
+}
+
+func isPublicIP(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+func validateAddr(value interface{}, is func(netip.Addr) bool, invalid error) error {
+	addr, err := parseAddr(value)
+	if err != nil {
+		return err
+	}
+	if !addr.IsValid() || is(addr) {
+		return nil
+	}
+	return invalid
+}
+
+// IsPublicIP check if the IP is globally reachable: not private, loopback, link local,
+// multicast, unspecified or reserved. Empty value is valid.
+func IsPublicIP(value interface{}, args ...string) error {
+	return validateAddr(value, isPublicIP, ErrNotPublicIP)
+}
+
+// IsPrivateIP check if the IP is private, RFC 1918 for IPv4 and RFC 4193 for IPv6. Empty value is valid.
+func IsPrivateIP(value interface{}, args ...string) error {
+	return validateAddr(value, func(addr netip.Addr) bool { return addr.Unmap().IsPrivate() }, ErrNotPrivateIP)
+}
+
+// IsLoopbackIP check if the IP is loopback. Empty value is valid.
+func IsLoopbackIP(value interface{}, args ...string) error {
+	return validateAddr(value, func(addr netip.Addr) bool { return addr.Unmap().IsLoopback() }, ErrNotLoopbackIP)
+}
+
+func validatePrefix(value interface{}, is4 bool, invalid error) error {
+	prefix, err := parsePrefix(value)
+	if err != nil {
+		return invalid
+	}
+	if prefix == (netip.Prefix{}) {
+		return nil
+	}
+	if !prefix.IsValid() {
+		return invalid
+	}
+	if prefix.Addr().Is4() == is4 {
+		return nil
+	}
+	return invalid
+}
+
+// IsCIDRv4 check if the value is an IPv4 CIDR. Empty value is valid.
+func IsCIDRv4(value interface{}, args ...string) error {
+	return validatePrefix(value, true, ErrInvalidCIDRv4)
+}
+
+// IsCIDRv6 check if the value is an IPv6 CIDR. Empty value is valid.
+func IsCIDRv6(value interface{}, args ...string) error {
+	return validatePrefix(value, false, ErrInvalidCIDRv6)
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("ip_in", CompileIPIn)
+	TagValidatorMap.RegisterCompileFunc("ip_not_in", CompileIPNotIn)
+}
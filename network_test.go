@@ -0,0 +1,173 @@
+package govalidator
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedIP(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, IsIP(net.ParseIP("1.2.3.4")))
+	require.NoError(t, IsIP(net.IP(nil)))
+	require.Error(t, IsIP(net.IP{1, 2, 3}))
+	require.NoError(t, IsIP(netip.MustParseAddr("::1")))
+	require.NoError(t, IsIP(netip.Addr{}))
+	require.NoError(t, IsIPv4(net.ParseIP("1.2.3.4")))
+	require.Error(t, IsIPv4(net.ParseIP("::1")))
+	require.NoError(t, IsIPv6(netip.MustParseAddr("::1")))
+	require.Error(t, IsIPv6(netip.MustParseAddr("1.2.3.4")))
+	require.NoError(t, IsCIDR(netip.MustParsePrefix("10.0.0.0/8")))
+	require.NoError(t, IsCIDR(netip.Prefix{}))
+	require.Error(t, IsCIDR(netip.PrefixFrom(netip.MustParseAddr("10.0.0.0"), 33)))
+}
+
+func TestIPRangeValidator(t *testing.T) {
+	t.Parallel()
+
+	in := CompileIPIn("10.0.0.0/8", "192.168.1.1", "2001:db8::/32")
+	notIn := CompileIPNotIn("10.0.0.0/8", "192.168.1.1", "2001:db8::/32")
+
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{"", true},
+		{"10.1.2.3", true},
+		{"192.168.1.1", true},
+		{"192.168.1.2", false},
+		{"::ffff:10.1.2.3", true},
+		{"2001:db8::1", true},
+		{"2001:db9::1", false},
+		{net.ParseIP("10.1.2.3"), true},
+		{net.IP(nil), true},
+		{netip.MustParseAddr("11.0.0.1"), false},
+		{netip.MustParsePrefix("10.1.0.0/16"), true},
+		{netip.MustParsePrefix("10.0.0.0/7"), false},
+	}
+	for _, test := range tests {
+		err := in.Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check ip_in(%v)", test.param)
+		} else {
+			require.Error(t, err, "check ip_in(%v)", test.param)
+		}
+
+		err = notIn.Validate(test.param)
+		if test.expected && !isEmptyIP(test.param) {
+			require.Error(t, err, "check ip_not_in(%v)", test.param)
+		} else {
+			require.NoError(t, err, "check ip_not_in(%v)", test.param)
+		}
+	}
+
+	require.Error(t, in.Validate("bad"))
+	require.Panics(t, func() { CompileIPIn("10.0.0.0/99") })
+}
+
+func isEmptyIP(value interface{}) bool {
+	switch v := value.(type) {
+	case string:
+		return v == ""
+	case net.IP:
+		return len(v) == 0
+	}
+	return false
+}
+
+func TestIsPublicIP(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		public   bool
+		private  bool
+		loopback bool
+	}{
+		{"8.8.8.8", true, false, false},
+		{"2606:4700:4700::1111", true, false, false},
+		{"10.0.0.1", false, true, false},
+		{"172.16.0.1", false, true, false},
+		{"192.168.0.1", false, true, false},
+		{"fd00::1", false, true, false},
+		{"127.0.0.1", false, false, true},
+		{"::1", false, false, true},
+		{"169.254.169.254", false, false, false},
+		{"fe80::1", false, false, false},
+		{"0.0.0.0", false, false, false},
+		{"100.64.0.1", false, false, false},
+		{"224.0.0.1", false, false, false},
+		{"255.255.255.255", false, false, false},
+		{"192.0.2.1", false, false, false},
+		{"::ffff:127.0.0.1", false, false, true},
+		{"::ffff:10.0.0.1", false, true, false},
+		{"::7f00:1", false, false, false},
+		{"64:ff9b::7f00:1", false, false, false},
+		{"64:ff9b::808:808", false, false, false},
+		{"64:ff9b:1::a00:1", false, false, false},
+		{"2002:7f00:1::1", false, false, false},
+		{"2002:808:808::1", false, false, false},
+		{"2001:0:4136:e378:8000:63bf:80ff:fffe", false, false, false},
+		{"2001:1::1", false, false, false},
+		{"2001:200::1", true, false, false},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%s)", name, test.param)
+			} else {
+				require.Error(t, err, "check %s(%s)", name, test.param)
+			}
+		}
+		check(IsPublicIP(test.param), test.public, "public_ip")
+		check(IsPrivateIP(test.param), test.private, "private_ip")
+		check(IsLoopbackIP(test.param), test.loopback, "loopback")
+	}
+
+	require.NoError(t, IsPublicIP(""))
+	require.Error(t, IsPublicIP("bad"))
+	require.Error(t, IsPublicIP(123))
+}
+
+func TestIsCIDRVersion(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, IsCIDRv4(""))
+	require.NoError(t, IsCIDRv4("10.0.0.0/8"))
+	require.NoError(t, IsCIDRv4(netip.MustParsePrefix("10.0.0.0/8")))
+	require.Error(t, IsCIDRv4("2001:db8::/32"))
+	require.Error(t, IsCIDRv4("10.0.0.0"))
+	require.NoError(t, IsCIDRv6("2001:db8::/32"))
+	require.Error(t, IsCIDRv6("10.0.0.0/8"))
+	require.Error(t, IsCIDRv6("2001:db8::/129"))
+}
+
+type stNetwork struct {
+	Callback string     `json:"callback" valid:"public_ip"`
+	Peer     net.IP     `json:"peer" valid:"ip_in(10.0.0.0/8)"`
+	Gateway  netip.Addr `json:"gateway" valid:"ip_not_in(0.0.0.0/8,127.0.0.0/8)"`
+	Subnet   string     `json:"subnet" valid:"cidrv4"`
+}
+
+func TestNetworkTags(t *testing.T) {
+	st := &stNetwork{
+		Callback: "8.8.8.8",
+		Peer:     net.ParseIP("10.0.0.1"),
+		Gateway:  netip.MustParseAddr("192.168.0.1"),
+		Subnet:   "10.0.0.0/24",
+	}
+	require.NoError(t, ValidateStruct(st))
+
+	st = &stNetwork{
+		Callback: "169.254.169.254",
+		Peer:     net.ParseIP("11.0.0.1"),
+		Gateway:  netip.MustParseAddr("127.0.0.1"),
+		Subnet:   "::/0",
+	}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+	require.Equal(t, []string{"callback", "peer", "gateway", "subnet"}, err.(Errors).Fields())
+}
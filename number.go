@@ -0,0 +1,532 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNotNumber       = errors.New("not a number")
+	ErrIsNaN           = errors.New("should not be NaN")
+	ErrNotFinite       = errors.New("should be finite")
+	ErrNotPositive     = errors.New("should be positive")
+	ErrNotNegative     = errors.New("should be negative")
+	ErrIsZero          = errors.New("should not be zero")
+	ErrInvalidDecimal  = errors.New("invalid decimal")
+	ErrInvalidMultiple = errors.New("invalid multiple")
+)
+
+func ErrDecimal(precision, scale int) error {
+	return fmt.Errorf("%w: should have at most %v digits and %v decimal places", ErrInvalidDecimal, precision, scale)
+}
+
+func ErrNotMultipleOf(value interface{}, step string) error {
+	return fmt.Errorf("%w: should be multiple of %v, but got %v", ErrInvalidMultiple, step, value)
+}
+
+func ErrInvalidNumberArg(funcName string, arg string) error {
+	return fmt.Errorf("function %v invalid number argument: %v", funcName, arg)
+}
+
+// numeric is a normalized number value. The float kinds keep the float64 to compare
+// with the float semantics, the *big.Float keeps its precision, others are exact *big.Rat.
+type numeric struct {
+	float   *float64
+	bitSize int
+	big     *big.Float
+	rat     *big.Rat
+}
+
+// toNumeric converts the value to numeric, the value can be any int, uint and float kinds,
+// *big.Int, *big.Float, *big.Rat, json.Number and numeric strings.
+// The ok is false if the value is empty string or nil pointer.
+func toNumeric(value interface{}) (n numeric, ok bool, err error) {
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return n, false, nil
+		}
+		n.rat = new(big.Rat).SetInt(v)
+		return n, true, nil
+	case *big.Float:
+		if v == nil {
+			return n, false, nil
+		}
+		n.big = v
+		return n, true, nil
+	case *big.Rat:
+		if v == nil {
+			return n, false, nil
+		}
+		n.rat = v
+		return n, true, nil
+	}
+
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n.rat = new(big.Rat).SetInt64(val.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n.rat = new(big.Rat).SetUint64(val.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		n.float, n.bitSize = &f, val.Type().Bits()
+	case reflect.String:
+		str := strings.TrimSpace(val.String())
+		if str == "" {
+			return n, false, nil
+		}
+		if n.rat = parseRat(str); n.rat == nil {
+			return n, false, ErrNotNumber
+		}
+	default:
+		return n, false, ErrNotNumber
+	}
+	return n, true, nil
+}
+
+// parseRat parses the decimal number string, returns nil if invalid.
+func parseRat(str string) *big.Rat {
+	if !rxDecimalNumber.MatchString(str) {
+		return nil
+	}
+	r, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return nil
+	}
+	return r
+}
+
+// mustParseRat parses the number argument of tag, panics if invalid.
+func mustParseRat(funcName string, arg string) *big.Rat {
+	r := parseRat(strings.TrimSpace(arg))
+	if r == nil {
+		panic(ErrInvalidNumberArg(funcName, arg))
+	}
+	return r
+}
+
+func (n numeric) isNaN() bool {
+	return n.float != nil && math.IsNaN(*n.float)
+}
+
+func (n numeric) isInf() bool {
+	return (n.float != nil && math.IsInf(*n.float, 0)) || (n.big != nil && n.big.IsInf())
+}
+
+func (n numeric) sign() int {
+	switch {
+	case n.float != nil:
+		switch {
+		case *n.float > 0:
+			return 1
+		case *n.float < 0:
+			return -1
+		}
+		return 0
+	case n.big != nil:
+		return n.big.Sign()
+	}
+	return n.rat.Sign()
+}
+
+// cmp compares the number with the number argument of tag, returns -1, 0 or +1.
+// The NaN should be checked before.
+func (n numeric) cmp(funcName string, arg string) int {
+	arg = strings.TrimSpace(arg)
+	switch {
+	case n.float != nil:
+		f, err := strconv.ParseFloat(arg, n.bitSize)
+		if err != nil {
+			panic(ErrInvalidNumberArg(funcName, arg))
+		}
+		switch {
+		case *n.float < f:
+			return -1
+		case *n.float > f:
+			return 1
+		}
+		return 0
+	case n.big != nil:
+		f, _, err := big.ParseFloat(arg, 10, n.big.Prec(), big.ToNearestEven)
+		if err != nil {
+			panic(ErrInvalidNumberArg(funcName, arg))
+		}
+		return n.big.Cmp(f)
+	}
+	return n.rat.Cmp(mustParseRat(funcName, arg))
+}
+
+// toRat converts the number to *big.Rat, the floats are converted from their shortest
+// decimal representation, so 0.1 is 1/10. Returns nil if NaN or Inf.
+func (n numeric) toRat() *big.Rat {
+	switch {
+	case n.float != nil:
+		if math.IsNaN(*n.float) || math.IsInf(*n.float, 0) {
+			return nil
+		}
+		return parseRat(strconv.FormatFloat(*n.float, 'g', -1, n.bitSize))
+	case n.big != nil:
+		if n.big.IsInf() {
+			return nil
+		}
+		return parseRat(n.big.Text('g', -1))
+	}
+	return n.rat
+}
+
+// compareNumber compares the value with the number argument of tag.
+// The ok is false if the value is empty.
+func compareNumber(funcName string, value interface{}, arg string) (cmp int, ok bool, err error) {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+	if n.isNaN() {
+		return 0, false, ErrIsNaN
+	}
+	return n.cmp(funcName, arg), true, nil
+}
+
+// numberArg is the number argument of tag parsed once when the tag is compiled. The int and
+// uint kinds are compared natively if the argument is an integer of their range, the float
+// kinds are compared with the argument parsed in their bit size, others with *big.Rat.
+type numberArg struct {
+	funcName string
+	arg      string
+	rat      *big.Rat
+	int      int64
+	isInt    bool
+	uint     uint64
+	isUint   bool
+	float64  float64
+	err64    error
+	float32  float64
+	err32    error
+}
+
+// newNumberArg parses the number argument of tag, panics if it's neither decimal nor float.
+func newNumberArg(funcName string, arg string) *numberArg {
+	arg = strings.TrimSpace(arg)
+	a := &numberArg{funcName: funcName, arg: arg, rat: parseRat(arg)}
+	a.float64, a.err64 = strconv.ParseFloat(arg, 64)
+	a.float32, a.err32 = strconv.ParseFloat(arg, 32)
+	if a.rat == nil && a.err64 != nil {
+		panic(ErrInvalidNumberArg(funcName, arg))
+	}
+	if a.rat != nil && a.rat.IsInt() {
+		if num := a.rat.Num(); num.IsInt64() {
+			a.int, a.isInt = num.Int64(), true
+		}
+		if num := a.rat.Num(); num.IsUint64() {
+			a.uint, a.isUint = num.Uint64(), true
+		}
+	}
+	return a
+}
+
+// compare compares the value with the argument, returns -1, 0 or +1.
+// The ok is false if the value is empty.
+func (a *numberArg) compare(value interface{}) (cmp int, ok bool, err error) {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.isInt {
+			return compareInt64(val.Int(), a.int), true, nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if a.isUint {
+			return compareUint64(val.Uint(), a.uint), true, nil
+		}
+	case reflect.Float32, reflect.Float64:
+		f := val.Float()
+		if math.IsNaN(f) {
+			return 0, false, ErrIsNaN
+		}
+		bound, err := a.float64, a.err64
+		if val.Type().Bits() == 32 {
+			bound, err = a.float32, a.err32
+		}
+		if err != nil {
+			panic(ErrInvalidNumberArg(a.funcName, a.arg))
+		}
+		return compareFloat64(f, bound), true, nil
+	}
+
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return 0, ok, err
+	}
+	if n.big != nil {
+		return n.cmp(a.funcName, a.arg), true, nil
+	}
+	if a.rat == nil {
+		panic(ErrInvalidNumberArg(a.funcName, a.arg))
+	}
+	return n.rat.Cmp(a.rat), true, nil
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloat64(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// MinValidator checks the number is at least Min.
+type MinValidator struct {
+	Min string
+	min *numberArg
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *MinValidator) Validate(value interface{}, args ...string) error {
+	cmp, ok, err := v.min.compare(value)
+	if err != nil || !ok || cmp >= 0 {
+		return err
+	}
+	return ErrLessThanMin(value, v.Min)
+}
+
+// CompileMin creates the validator of tag `min(1)`.
+func CompileMin(args ...string) Validator {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("min", 1))
+	}
+	return &MinValidator{Min: args[0], min: newNumberArg("min", args[0])}
+}
+
+// MaxValidator checks the number is at most Max.
+type MaxValidator struct {
+	Max string
+	max *numberArg
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *MaxValidator) Validate(value interface{}, args ...string) error {
+	cmp, ok, err := v.max.compare(value)
+	if err != nil || !ok || cmp <= 0 {
+		return err
+	}
+	return ErrGreatThanMax(value, v.Max)
+}
+
+// CompileMax creates the validator of tag `max(100)`.
+func CompileMax(args ...string) Validator {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("max", 1))
+	}
+	return &MaxValidator{Max: args[0], max: newNumberArg("max", args[0])}
+}
+
+// RangeValidator checks the number is in the interval of Lower and Upper,
+// see Range for the interval syntax.
+type RangeValidator struct {
+	Lower        string
+	Upper        string
+	min          *numberArg
+	minExclusive bool
+	max          *numberArg
+	maxExclusive bool
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *RangeValidator) Validate(value interface{}, args ...string) error {
+	cmpMin, ok, err := v.min.compare(value)
+	if err != nil || !ok {
+		return err
+	}
+	cmpMax, _, _ := v.max.compare(value)
+	if (cmpMin > 0 || (!v.minExclusive && cmpMin == 0)) && (cmpMax < 0 || (!v.maxExclusive && cmpMax == 0)) {
+		return nil
+	}
+	if !v.minExclusive && !v.maxExclusive {
+		return ErrNotInRange(value, v.min.arg, v.max.arg)
+	}
+	return ErrNotInInterval(value, v.Lower, v.Upper)
+}
+
+// CompileRange creates the validator of tag `range(1,100)` or `range(0,1]`.
+func CompileRange(args ...string) Validator {
+	if len(args) != 2 {
+		panic(ErrNumArgsInvalid("range", 2))
+	}
+	min, minExclusive, max, maxExclusive := parseInterval(args[0], args[1])
+	return &RangeValidator{
+		Lower:        args[0],
+		Upper:        args[1],
+		min:          newNumberArg("range", min),
+		minExclusive: minExclusive,
+		max:          newNumberArg("range", max),
+		maxExclusive: maxExclusive,
+	}
+}
+
+// IsFinite check if the number is not NaN or Inf. Empty string is valid.
+func IsFinite(value interface{}, args ...string) error {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return err
+	}
+	if n.isNaN() || n.isInf() {
+		return ErrNotFinite
+	}
+	return nil
+}
+
+// IsPositive check if the number is greater than zero. Empty string is valid.
+func IsPositive(value interface{}, args ...string) error {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return err
+	}
+	if n.isNaN() || n.sign() <= 0 {
+		return ErrNotPositive
+	}
+	return nil
+}
+
+// IsNegative check if the number is less than zero. Empty string is valid.
+func IsNegative(value interface{}, args ...string) error {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return err
+	}
+	if n.isNaN() || n.sign() >= 0 {
+		return ErrNotNegative
+	}
+	return nil
+}
+
+// IsNonZero check if the number is not zero, NaN is not zero. Empty string is valid.
+func IsNonZero(value interface{}, args ...string) error {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return err
+	}
+	if !n.isNaN() && n.sign() == 0 {
+		return ErrIsZero
+	}
+	return nil
+}
+
+// DecimalValidator checks the number has at most Precision significant digits, of which
+// at most Scale digits are after the decimal point, like the SQL DECIMAL(precision,scale).
+type DecimalValidator struct {
+	Precision int
+	Scale     int
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *DecimalValidator) Validate(value interface{}, args ...string) error {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return err
+	}
+	r := n.toRat()
+	if r == nil {
+		return ErrNotFinite
+	}
+
+	// the scale is the digits after decimal point, fits if r * 10^scale is integer
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.Scale)), nil)))
+	if !scaled.IsInt() {
+		return ErrDecimal(v.Precision, v.Scale)
+	}
+
+	// the integer digits
+	intPart := new(big.Int).Quo(r.Num(), r.Denom())
+	intPart.Abs(intPart)
+	intDigits := 0
+	if intPart.Sign() != 0 {
+		intDigits = len(intPart.String())
+	}
+	if intDigits > v.Precision-v.Scale {
+		return ErrDecimal(v.Precision, v.Scale)
+	}
+	return nil
+}
+
+// CompileDecimal creates the validator of tag `decimal(precision,scale)`.
+func CompileDecimal(args ...string) Validator {
+	if len(args) != 2 {
+		panic(ErrNumArgsInvalid("decimal", 2))
+	}
+	precision, err1 := strconv.Atoi(strings.TrimSpace(args[0]))
+	scale, err2 := strconv.Atoi(strings.TrimSpace(args[1]))
+	if err1 != nil || err2 != nil || precision <= 0 || scale < 0 || scale > precision {
+		panic(ErrInvalidNumberArg("decimal", strings.Join(args, ",")))
+	}
+	return &DecimalValidator{Precision: precision, Scale: scale}
+}
+
+// MultipleOfValidator checks the number is an integer multiple of Step, computed exactly
+// with *big.Rat, so 0.3 is a multiple of 0.1.
+type MultipleOfValidator struct {
+	Arg  string
+	Step *big.Rat
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *MultipleOfValidator) Validate(value interface{}, args ...string) error {
+	n, ok, err := toNumeric(value)
+	if err != nil || !ok {
+		return err
+	}
+	r := n.toRat()
+	if r == nil {
+		return ErrNotFinite
+	}
+	if !new(big.Rat).Quo(r, v.Step).IsInt() {
+		return ErrNotMultipleOf(value, v.Arg)
+	}
+	return nil
+}
+
+// CompileMultipleOf creates the validator of tag `multiple_of(0.01)`.
+func CompileMultipleOf(args ...string) Validator {
+	if len(args) != 1 {
+		panic(ErrNumArgsInvalid("multiple_of", 1))
+	}
+	step := mustParseRat("multiple_of", args[0])
+	if step.Sign() <= 0 {
+		panic(ErrInvalidNumberArg("multiple_of", args[0]))
+	}
+	return &MultipleOfValidator{Arg: args[0], Step: step}
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("min", CompileMin)
+	TagValidatorMap.RegisterCompileFunc("max", CompileMax)
+	TagValidatorMap.RegisterCompileFunc("range", CompileRange)
+	TagValidatorMap.RegisterCompileFunc("decimal", CompileDecimal)
+	TagValidatorMap.RegisterCompileFunc("multiple_of", CompileMultipleOf)
+}
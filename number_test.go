@@ -0,0 +1,254 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMaxRangeNumberKinds(t *testing.T) {
+	t.Parallel()
+
+	type Amount int64
+
+	bigInt, _ := new(big.Int).SetString("100000000000000000000", 10)
+
+	var tests = []struct {
+		value    interface{}
+		min      string
+		max      string
+		expected bool
+	}{
+		{5, "1", "10", true},
+		{0, "1", "10", false},
+		{uint8(10), "1", "10", true},
+		{Amount(11), "1", "10", false},
+		{-1, "-1.5", "0", true},
+		{0.1, "0.1", "0.1", true},
+		{float32(0.1), "0.1", "0.2", true},
+		{10.5, "1", "10", false},
+		{math.Inf(1), "1", "10", false},
+		{math.Inf(-1), "-1e308", "0", false},
+		{math.NaN(), "-1e308", "1e308", false},
+		{bigInt, "1e20", "1e20", true},
+		{bigInt, "0", "99999999999999999999", false},
+		{big.NewFloat(1.5), "1.5", "2", true},
+		{big.NewFloat(0.5), "1", "2", false},
+		{(*big.Int)(nil), "1", "2", true},
+		{json.Number("12.345"), "0", "100", true},
+		{json.Number("-1"), "0", "100", false},
+		{"12.345", "12.345", "12.345", true},
+		{"1e3", "0", "100", false},
+		{"", "1", "10", true},
+		{"abc", "1", "10", false},
+		{"1/2", "0", "1", false},
+		{true, "0", "1", false},
+	}
+	for _, test := range tests {
+		minErr := Min(test.value, test.min)
+		maxErr := Max(test.value, test.max)
+		rangeErr := Range(test.value, test.min, test.max)
+		if test.expected {
+			require.NoError(t, minErr, "check Min(%v, %v)", test.value, test.min)
+			require.NoError(t, maxErr, "check Max(%v, %v)", test.value, test.max)
+			require.NoError(t, rangeErr, "check Range(%v, %v, %v)", test.value, test.min, test.max)
+		} else {
+			require.Error(t, rangeErr, "check Range(%v, %v, %v)", test.value, test.min, test.max)
+			require.True(t, minErr != nil || maxErr != nil, "check Min/Max(%v)", test.value)
+		}
+	}
+
+	require.ErrorIs(t, Min(math.NaN(), "0"), ErrIsNaN)
+	require.ErrorIs(t, Max(math.NaN(), "0"), ErrIsNaN)
+	require.ErrorIs(t, Range(math.NaN(), "0", "1"), ErrIsNaN)
+	require.Panics(t, func() { _ = Min(1, "abc") })
+}
+
+func TestCompileMinMaxRange(t *testing.T) {
+	huge := "100000000000000000000"
+	var tests = []struct {
+		value    interface{}
+		min      string
+		max      string
+		expected bool
+	}{
+		{int64(math.MaxInt64), "9223372036854775807", huge, true},
+		{int64(math.MinInt64), "-9223372036854775808", "0", true},
+		{int64(math.MaxInt64), huge, huge, false},
+		{uint64(math.MaxUint64), "18446744073709551615", huge, true},
+		{uint64(0), "-1", "0", true},
+		{uint(5), "5.5", "10", false},
+		{5, "4.5", "5.5", true},
+		{float32(0.1), "0.1", "0.1", true},
+		{0.1, "0.1", "0.1", true},
+		{json.Number("5"), "4.5", "5.5", true},
+	}
+	for _, test := range tests {
+		min, max := CompileMin(test.min), CompileMax(test.max)
+		rangeValidator := CompileRange(test.min, test.max)
+		if test.expected {
+			require.NoError(t, min.Validate(test.value), "check min(%v, %v)", test.value, test.min)
+			require.NoError(t, max.Validate(test.value), "check max(%v, %v)", test.value, test.max)
+			require.NoError(t, rangeValidator.Validate(test.value), "check range(%v, %v, %v)", test.value, test.min, test.max)
+		} else {
+			require.Error(t, rangeValidator.Validate(test.value), "check range(%v, %v, %v)", test.value, test.min, test.max)
+		}
+	}
+
+	require.Panics(t, func() { CompileMin("abc") })
+	require.Panics(t, func() { CompileRange("(0") })
+
+	// the int, uint and float kinds are compared without allocation
+	v := CompileRange("(0", "100]")
+	for _, value := range []interface{}{50, uint16(50), 50.5} {
+		require.Zero(t, testing.AllocsPerRun(100, func() { _ = v.Validate(value) }), "check range(%v)", value)
+	}
+}
+
+func TestNumberSign(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		value    interface{}
+		finite   bool
+		positive bool
+		negative bool
+		nonzero  bool
+	}{
+		{"", true, true, true, true},
+		{1, true, true, false, true},
+		{-1, true, false, true, true},
+		{0, true, false, false, false},
+		{uint(0), true, false, false, false},
+		{0.0, true, false, false, false},
+		{math.Copysign(0, -1), true, false, false, false},
+		{math.NaN(), false, false, false, true},
+		{math.Inf(1), false, true, false, true},
+		{math.Inf(-1), false, false, true, true},
+		{"-0.01", true, false, true, true},
+		{json.Number("0"), true, false, false, false},
+		{big.NewInt(-3), true, false, true, true},
+		{new(big.Float).SetInf(false), false, true, false, true},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%v)", name, test.value)
+			} else {
+				require.Error(t, err, "check %s(%v)", name, test.value)
+			}
+		}
+		check(IsFinite(test.value), test.finite, "finite")
+		check(IsPositive(test.value), test.positive, "positive")
+		check(IsNegative(test.value), test.negative, "negative")
+		check(IsNonZero(test.value), test.nonzero, "nonzero")
+	}
+
+	require.ErrorIs(t, IsPositive("abc"), ErrNotNumber)
+}
+
+func TestDecimal(t *testing.T) {
+	t.Parallel()
+
+	money := CompileDecimal("10", "2")
+
+	var tests = []struct {
+		value    interface{}
+		expected bool
+	}{
+		{"", true},
+		{"0", true},
+		{"12.34", true},
+		{"-12.34", true},
+		{"12.340", true},
+		{"12.345", false},
+		{"99999999.99", true},
+		{"100000000", false},
+		{"1.2e3", true},
+		{"1.2345e1", false},
+		{12.34, true},
+		{0.30000000000000004, false},
+		{12.345, false},
+		{float32(12.34), true},
+		{json.Number("0.01"), true},
+		{big.NewInt(12345678), true},
+		{math.NaN(), false},
+		{"abc", false},
+	}
+	for _, test := range tests {
+		err := money.Validate(test.value)
+		if test.expected {
+			require.NoError(t, err, "check decimal(10,2)(%v)", test.value)
+		} else {
+			require.Error(t, err, "check decimal(10,2)(%v)", test.value)
+		}
+	}
+
+	require.True(t, errors.Is(money.Validate("12.345"), ErrInvalidDecimal))
+	require.Panics(t, func() { CompileDecimal("2", "3") })
+	require.Panics(t, func() { CompileDecimal("10") })
+}
+
+func TestMultipleOf(t *testing.T) {
+	t.Parallel()
+
+	cent := CompileMultipleOf("0.01")
+	five := CompileMultipleOf("5")
+
+	var tests = []struct {
+		validator Validator
+		value     interface{}
+		expected  bool
+	}{
+		{cent, "", true},
+		{cent, "12.34", true},
+		{cent, "12.345", false},
+		{cent, 12.34, true},
+		{cent, 0.3, true},
+		{cent, 1.005, false},
+		{cent, float32(19.99), true},
+		{cent, json.Number("-0.05"), true},
+		{cent, math.Inf(1), false},
+		{five, 15, true},
+		{five, 0, true},
+		{five, -10, true},
+		{five, 12, false},
+		{five, uint64(25), true},
+		{five, "7.5", false},
+	}
+	for _, test := range tests {
+		err := test.validator.Validate(test.value)
+		if test.expected {
+			require.NoError(t, err, "check multiple_of(%v)", test.value)
+		} else {
+			require.Error(t, err, "check multiple_of(%v)", test.value)
+		}
+	}
+
+	require.Panics(t, func() { CompileMultipleOf("0") })
+	require.Panics(t, func() { CompileMultipleOf("abc") })
+}
+
+func TestNumberStruct(t *testing.T) {
+	t.Parallel()
+
+	type Order struct {
+		Price    string      `valid:"decimal(10,2);positive"`
+		Quantity json.Number `valid:"range(1,100);multiple_of(1)"`
+		Discount float64     `valid:"finite;range(0,1)"`
+	}
+
+	require.NoError(t, ValidateStruct(&Order{Price: "12.34", Quantity: "3", Discount: 0.1}))
+
+	err := ValidateStruct(&Order{Price: "-12.345", Quantity: "1.5", Discount: math.NaN()})
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.True(t, errs.HasCode("decimal"))
+	require.True(t, errs.HasCode("positive"))
+	require.True(t, errs.HasCode("multiple_of"))
+	require.True(t, errs.HasCode("finite"))
+}
@@ -0,0 +1,211 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ErrNotHasDigit   = errors.New("not has digit")
+	ErrNotHasSymbol  = errors.New("not has symbol")
+	ErrHasWhitespace = errors.New("has whitespace")
+	ErrWeakPassword  = errors.New("weak password")
+)
+
+func ErrUnknownPasswordOption(option string) error {
+	return fmt.Errorf("unknown password option: %v", option)
+}
+
+// PasswordError lists the unmet requirements of the password, it wraps ErrWeakPassword.
+type PasswordError struct {
+	Unmet []string
+}
+
+func (e *PasswordError) Error() string {
+	return ErrWeakPassword.Error() + ": " + strings.Join(e.Unmet, ", ")
+}
+
+func (e *PasswordError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// ContainsLower check if the string contains a lowercase letter. Empty string is valid.
+func ContainsLower(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxHasLowerCase.MatchString(str) {
+		return nil
+	}
+	return ErrNotHasLowerCase
+}
+
+// ContainsUpper check if the string contains an uppercase letter. Empty string is valid.
+func ContainsUpper(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxHasUpperCase.MatchString(str) {
+		return nil
+	}
+	return ErrNotHasUpperCase
+}
+
+// ContainsDigit check if the string contains a digit. Empty string is valid.
+func ContainsDigit(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || strings.IndexFunc(str, unicode.IsDigit) != -1 {
+		return nil
+	}
+	return ErrNotHasDigit
+}
+
+// ContainsSymbol check if the string contains a punctuation or symbol character. Empty string is valid.
+func ContainsSymbol(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || strings.IndexFunc(str, isSymbol) != -1 {
+		return nil
+	}
+	return ErrNotHasSymbol
+}
+
+// NoWhitespace check if the string contains no whitespace. Empty string is valid.
+func NoWhitespace(value interface{}, args ...string) error {
+	str := assertString(value)
+	if !rxHasWhitespace.MatchString(str) {
+		return nil
+	}
+	return ErrHasWhitespace
+}
+
+func isSymbol(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// PasswordEntropy estimates the entropy bits of the password by its length and the
+// size of the character classes it uses.
+func PasswordEntropy(password string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case 'a' <= r && r <= 'z':
+			lower = true
+		case 'A' <= r && r <= 'Z':
+			upper = true
+		case '0' <= r && r <= '9':
+			digit = true
+		case r < utf8.RuneSelf && (isSymbol(r) || r == ' '):
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+}
+
+// PasswordValidator checks the password policy, all the unmet requirements are reported in one PasswordError.
+// Classes is the minimum number of the character classes used among lowercase, uppercase, digit and symbol.
+type PasswordValidator struct {
+	MinLength  int
+	MaxLength  int
+	Classes    int
+	MinEntropy float64
+}
+
+// NewPasswordValidator creates the password validator from the options: min=12, max=64, classes=3, entropy=60.
+func NewPasswordValidator(options ...string) *PasswordValidator {
+	v := &PasswordValidator{}
+	for _, option := range options {
+		p := strings.IndexByte(option, '=')
+		if p == -1 {
+			panic(ErrUnknownPasswordOption(option))
+		}
+		name, value := option[:p], option[p+1:]
+
+		switch name {
+		case "min":
+			v.MinLength = GetInt(value)
+		case "max":
+			v.MaxLength = GetInt(value)
+		case "classes":
+			v.Classes = GetInt(value)
+		case "entropy":
+			v.MinEntropy = GetFloat64(value)
+		default:
+			panic(ErrUnknownPasswordOption(option))
+		}
+	}
+	return v
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *PasswordValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	var unmet []string
+	length := utf8.RuneCountInString(str)
+	if v.MinLength > 0 && length < v.MinLength {
+		unmet = append(unmet, fmt.Sprintf("at least %v characters", v.MinLength))
+	}
+	if v.MaxLength > 0 && length > v.MaxLength {
+		unmet = append(unmet, fmt.Sprintf("at most %v characters", v.MaxLength))
+	}
+
+	if v.Classes > 0 {
+		classes := 0
+		var missing []string
+		for _, class := range []struct {
+			name string
+			err  error
+		}{
+			{"lowercase", ContainsLower(str)},
+			{"uppercase", ContainsUpper(str)},
+			{"digit", ContainsDigit(str)},
+			{"symbol", ContainsSymbol(str)},
+		} {
+			if class.err == nil {
+				classes++
+			} else {
+				missing = append(missing, class.name)
+			}
+		}
+		if classes < v.Classes {
+			unmet = append(unmet, fmt.Sprintf("at least %v of lowercase, uppercase, digit and symbol (missing %v)",
+				v.Classes, strings.Join(missing, ", ")))
+		}
+	}
+
+	if v.MinEntropy > 0 && PasswordEntropy(str) < v.MinEntropy {
+		unmet = append(unmet, fmt.Sprintf("at least %v bits entropy", v.MinEntropy))
+	}
+
+	if len(unmet) > 0 {
+		return &PasswordError{Unmet: unmet}
+	}
+	return nil
+}
+
+// CompilePassword creates the validator of tag `password(min=12,classes=3)`, see NewPasswordValidator for the options.
+func CompilePassword(args ...string) Validator {
+	return NewPasswordValidator(args...)
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("password", CompilePassword)
+}
@@ -0,0 +1,98 @@
+package govalidator
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharacterClasses(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		lower  bool
+		upper  bool
+		digit  bool
+		symbol bool
+		nospc  bool
+	}{
+		{"", true, true, true, true, true},
+		{"abc", true, false, false, false, true},
+		{"ABC", false, true, false, false, true},
+		{"123", false, false, true, false, true},
+		{"!@#", false, false, false, true, true},
+		{"aB3$", true, true, true, true, true},
+		{"a b", true, false, false, false, false},
+		{"a\tb", true, false, false, false, false},
+		{"äÖ€", true, true, false, true, true},
+		{"a\u3000b", true, false, false, false, false},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%s)", name, test.param)
+			} else {
+				require.Error(t, err, "check %s(%s)", name, test.param)
+			}
+		}
+		check(ContainsLower(test.param), test.lower, "contains_lower")
+		check(ContainsUpper(test.param), test.upper, "contains_upper")
+		check(ContainsDigit(test.param), test.digit, "contains_digit")
+		check(ContainsSymbol(test.param), test.symbol, "contains_symbol")
+		check(NoWhitespace(test.param), test.nospc, "no_whitespace")
+	}
+}
+
+func TestPasswordEntropy(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, float64(0), PasswordEntropy(""))
+	require.InDelta(t, 8*4.7, PasswordEntropy("abcdefgh"), 0.1)
+	require.InDelta(t, 8*math.Log2(52), PasswordEntropy("abcdEFGH"), 0.1)
+	require.True(t, PasswordEntropy("aB3$aB3$") > PasswordEntropy("abcdefgh"))
+}
+
+func TestPasswordValidator(t *testing.T) {
+	t.Parallel()
+
+	v := NewPasswordValidator("min=12", "classes=3", "entropy=60")
+	require.NoError(t, v.Validate(""))
+	require.NoError(t, v.Validate("CorrectHorse9battery"))
+
+	err := v.Validate("password")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrWeakPassword))
+
+	var passwordErr *PasswordError
+	require.True(t, errors.As(err, &passwordErr))
+	require.Len(t, passwordErr.Unmet, 3)
+	require.Equal(t, "weak password: at least 12 characters, "+
+		"at least 3 of lowercase, uppercase, digit and symbol (missing uppercase, digit, symbol), "+
+		"at least 60 bits entropy", err.Error())
+
+	err = NewPasswordValidator("max=4").Validate("password")
+	require.Equal(t, "weak password: at most 4 characters", err.Error())
+
+	require.Panics(t, func() { NewPasswordValidator("min") })
+	require.Panics(t, func() { NewPasswordValidator("unknown=1") })
+}
+
+type stPassword struct {
+	Password string `json:"password" valid:"required;no_whitespace;password(min=8,classes=3)"`
+	PIN      string `json:"pin" valid:"contains_digit"`
+}
+
+func TestPasswordTags(t *testing.T) {
+	require.NoError(t, ValidateStruct(&stPassword{Password: "Secret123", PIN: "1234"}))
+
+	err := ValidateStruct(&stPassword{Password: "secret 1", PIN: "abcd"})
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Len(t, errs.ByField("password"), 2)
+	require.True(t, errs.HasCode("no_whitespace"))
+	require.True(t, errs.HasCode("password"))
+	require.True(t, errs.HasCode("contains_digit"))
+}
@@ -0,0 +1,107 @@
+package govalidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SegmentKind is the kind of a path segment.
+type SegmentKind uint8
+
+const (
+	// FieldSegment is a struct field.
+	FieldSegment SegmentKind = iota
+	// IndexSegment is a slice or array index.
+	IndexSegment
+	// KeySegment is a map key.
+	KeySegment
+)
+
+// Segment is an element of the path of an error.
+type Segment struct {
+	Kind  SegmentKind
+	Name  string // field name or formatted map key
+	Index int
+}
+
+// PathField creates a struct field segment.
+func PathField(name string) Segment {
+	return Segment{Kind: FieldSegment, Name: name}
+}
+
+// PathIndex creates a slice or array index segment.
+func PathIndex(index int) Segment {
+	return Segment{Kind: IndexSegment, Index: index}
+}
+
+// PathKey creates a map key segment.
+func PathKey(key interface{}) Segment {
+	return Segment{Kind: KeySegment, Name: fmt.Sprint(key)}
+}
+
+// Path is the location of an error in the validated value, e.g. `users[0].name`.
+// A path is never modified once created, joining paths always allocates a new one.
+type Path []Segment
+
+// Join returns a new path of p followed by child.
+func (p Path) Join(child Path) Path {
+	path := make(Path, 0, len(p)+len(child))
+	path = append(path, p...)
+	return append(path, child...)
+}
+
+// String renders the path, empty field names are omitted.
+func (p Path) String() string {
+	var b strings.Builder
+	for _, seg := range p {
+		switch seg.Kind {
+		case FieldSegment:
+			if seg.Name == "" {
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(seg.Name)
+		case IndexSegment:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(seg.Index))
+			b.WriteByte(']')
+		case KeySegment:
+			b.WriteByte('[')
+			b.WriteString(formatKey(seg.Name))
+			b.WriteByte(']')
+		}
+	}
+	return b.String()
+}
+
+// formatKey quotes the map key which is empty or contains the path separators, so the
+// rendered path is unambiguous, e.g. `m["a.b"]` instead of `m[a.b]`.
+func formatKey(key string) string {
+	if key == "" || strings.ContainsAny(key, `.[]"`) {
+		return strconv.Quote(key)
+	}
+	return key
+}
+
+// withPath returns err located under path, without modifying err.
+func withPath(err error, path ...Segment) error {
+	switch v := err.(type) {
+	case Errors:
+		es := make(Errors, 0, len(v))
+		for _, e := range v {
+			es = append(es, e.withParent(path))
+		}
+		return es
+	case *Error:
+		return v.withParent(path)
+	}
+	p := Path(path).Join(nil)
+	return &Error{
+		Name: p.String(),
+		Path: p,
+		Err:  err,
+	}
+}
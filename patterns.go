@@ -10,6 +10,7 @@ const (
 	Numeric           string = "^[0-9]+$"
 	Int               string = "^(?:[-+]?(?:0|[1-9][0-9]*))$"
 	Float             string = "^(?:[-+]?(?:[0-9]+))?(?:\\.[0-9]*)?(?:[eE][\\+\\-]?(?:[0-9]+))?$"
+	DecimalNumber     string = "^[-+]?(?:[0-9]+(?:\\.[0-9]*)?|\\.[0-9]+)(?:[eE][-+]?[0-9]{1,4})?$"
 	ASCII             string = "^[\x00-\x7F]+$"
 	Base64            string = "^(?:[A-Za-z0-9+\\/]{4})*(?:[A-Za-z0-9+\\/]{2}==|[A-Za-z0-9+\\/]{3}=|[A-Za-z0-9+\\/]{4})$"
 	PrintableASCII    string = "^[\x20-\x7E]+$"
@@ -23,10 +24,10 @@ const (
 	URLIP             string = `([1-9]\d?|1\d\d|2[01]\d|22[0-3])(\.(1?\d{1,2}|2[0-4]\d|25[0-5])){2}(?:\.([0-9]\d?|1\d\d|2[0-4]\d|25[0-4]))`
 	URLSubdomain      string = `((www\.)|([a-zA-Z0-9]+([-_\.]?[a-zA-Z0-9])*[a-zA-Z0-9]\.[a-zA-Z0-9]+))`
 	URL               string = `^` + URLSchema + `?` + URLUsername + `?` + `((` + URLIP + `|(\[` + IP + `\])|(([a-zA-Z0-9]([a-zA-Z0-9-_]+)?[a-zA-Z0-9]([-\.][a-zA-Z0-9]+)*)|(` + URLSubdomain + `?))?(([a-zA-Z\x{00a1}-\x{ffff}0-9]+-?-?)*[a-zA-Z\x{00a1}-\x{ffff}0-9]+)(?:\.([a-zA-Z\x{00a1}-\x{ffff}]{1,}))?))\.?` + URLPort + `?` + URLPath + `?$`
-	hasLowerCase      string = ".*[[:lower:]]"
-	hasUpperCase      string = ".*[[:upper:]]"
-	hasWhitespace     string = ".*[[:space:]]"
-	hasWhitespaceOnly string = "^[[:space:]]+$"
+	hasLowerCase      string = ".*\\p{Ll}"
+	hasUpperCase      string = ".*\\p{Lu}"
+	hasWhitespace     string = ".*[[:space:]\\p{Z}]"
+	hasWhitespaceOnly string = "^[[:space:]\\p{Z}]+$"
 )
 
 var (
@@ -39,6 +40,7 @@ var (
 	rxNumeric           = regexp.MustCompile(Numeric)
 	rxInt               = regexp.MustCompile(Int)
 	rxFloat             = regexp.MustCompile(Float)
+	rxDecimalNumber     = regexp.MustCompile(DecimalNumber)
 	rxASCII             = regexp.MustCompile(ASCII)
 	rxPrintableASCII    = regexp.MustCompile(PrintableASCII)
 	rxBase64            = regexp.MustCompile(Base64)
@@ -0,0 +1,362 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	ErrInvalidE164            = errors.New("invalid E.164 phone number")
+	ErrInvalidPhone           = errors.New("invalid phone number")
+	ErrUnknownCallingCode     = errors.New("unknown country calling code")
+	ErrPhoneRegionNotAllowed  = errors.New("phone region not allowed")
+	ErrPhoneRegionRequired    = errors.New("phone region required for national number")
+	ErrNotMobilePhone         = errors.New("not mobile phone number")
+	ErrInvalidNationalPattern = errors.New("invalid national number")
+)
+
+func ErrUnknownPhoneRegion(region string) error {
+	return fmt.Errorf("unknown phone region: %v", region)
+}
+
+// rxE164 is the E.164 format, + and at most 15 digits without leading zero.
+var rxE164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// PhoneRegion is the numbering plan metadata of a region.
+type PhoneRegion struct {
+	// Region is the ISO 3166-1 alpha-2 code.
+	Region string
+	// CallingCode is the country calling code without +.
+	CallingCode string
+	// TrunkPrefix is the national prefix dialed before the national number, stripped in E.164.
+	TrunkPrefix string
+	// Lengths are the valid lengths of the national significant number.
+	Lengths []int
+	// Pattern is the optional pattern of the national significant number.
+	Pattern *regexp.Regexp
+	// MobilePrefixes are the prefixes of the mobile national numbers, empty if mobile
+	// numbers can not be told apart like the North American Numbering Plan.
+	MobilePrefixes []string
+}
+
+func (r *PhoneRegion) valid(national string) bool {
+	for _, length := range r.Lengths {
+		if len(national) == length {
+			return r.Pattern == nil || r.Pattern.MatchString(national)
+		}
+	}
+	return false
+}
+
+func (r *PhoneRegion) isMobile(national string) bool {
+	if len(r.MobilePrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range r.MobilePrefixes {
+		if strings.HasPrefix(national, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// nationalNumber strips the trunk prefix of the number if the rest is valid.
+func (r *PhoneRegion) nationalNumber(digits string) (string, bool) {
+	if r.TrunkPrefix != "" && strings.HasPrefix(digits, r.TrunkPrefix) {
+		if national := digits[len(r.TrunkPrefix):]; r.valid(national) {
+			return national, true
+		}
+	}
+	return digits, r.valid(digits)
+}
+
+// phoneRegionData is the embedded numbering plan metadata.
+var phoneRegionData = []*PhoneRegion{
+	{"CN", "86", "0", []int{10, 11}, regexp.MustCompile(`^(1[3-9]\d{9}|(10|2\d)\d{8}|[3-9]\d{9,10})$`), []string{"13", "14", "15", "16", "17", "18", "19"}},
+	{"HK", "852", "", []int{8}, regexp.MustCompile(`^[2-9]\d{7}$`), []string{"5", "6", "7", "9"}},
+	{"MO", "853", "", []int{8}, regexp.MustCompile(`^[268]\d{7}$`), []string{"6"}},
+	{"TW", "886", "0", []int{8, 9}, regexp.MustCompile(`^[2-9]\d{7,8}$`), []string{"9"}},
+	{"US", "1", "1", []int{10}, regexp.MustCompile(`^[2-9]\d{2}[2-9]\d{6}$`), nil},
+	{"CA", "1", "1", []int{10}, regexp.MustCompile(`^(204|226|236|249|250|263|289|306|343|354|365|367|368|382|403|416|418|428|431|437|438|450|468|474|506|514|519|548|579|581|584|587|604|613|639|647|672|683|705|709|742|753|778|780|782|807|819|825|867|873|879|902|905)[2-9]\d{6}$`), nil},
+	{"GB", "44", "0", []int{9, 10}, regexp.MustCompile(`^[1-9]\d{8,9}$`), []string{"7"}},
+	{"DE", "49", "0", []int{6, 7, 8, 9, 10, 11, 12, 13}, regexp.MustCompile(`^[1-9]\d+$`), []string{"15", "16", "17"}},
+	{"FR", "33", "0", []int{9}, regexp.MustCompile(`^[1-9]\d{8}$`), []string{"6", "7"}},
+	{"IT", "39", "", []int{6, 7, 8, 9, 10, 11}, regexp.MustCompile(`^[03]\d+$`), []string{"3"}},
+	{"ES", "34", "", []int{9}, regexp.MustCompile(`^[6-9]\d{8}$`), []string{"6", "7"}},
+	{"NL", "31", "0", []int{9}, regexp.MustCompile(`^[1-9]\d{8}$`), []string{"6"}},
+	{"RU", "7", "8", []int{10}, regexp.MustCompile(`^[3489]\d{9}$`), []string{"9"}},
+	{"JP", "81", "0", []int{9, 10}, regexp.MustCompile(`^[1-9]\d{8,9}$`), []string{"70", "80", "90"}},
+	{"KR", "82", "0", []int{8, 9, 10}, regexp.MustCompile(`^[1-9]\d{7,9}$`), []string{"10"}},
+	{"SG", "65", "", []int{8}, regexp.MustCompile(`^[3689]\d{7}$`), []string{"8", "9"}},
+	{"MY", "60", "0", []int{9, 10}, regexp.MustCompile(`^[1-9]\d{8,9}$`), []string{"1"}},
+	{"TH", "66", "0", []int{8, 9}, regexp.MustCompile(`^[2-9]\d{7,8}$`), []string{"6", "8", "9"}},
+	{"VN", "84", "0", []int{9, 10}, regexp.MustCompile(`^[2-9]\d{8,9}$`), []string{"3", "5", "7", "8", "9"}},
+	{"ID", "62", "0", []int{9, 10, 11, 12}, regexp.MustCompile(`^[2-9]\d{8,11}$`), []string{"8"}},
+	{"PH", "63", "0", []int{8, 9, 10}, regexp.MustCompile(`^[2-9]\d{7,9}$`), []string{"9"}},
+	{"IN", "91", "0", []int{10}, regexp.MustCompile(`^[1-9]\d{9}$`), []string{"6", "7", "8", "9"}},
+	{"AU", "61", "0", []int{9}, regexp.MustCompile(`^[2-478]\d{8}$`), []string{"4"}},
+	{"AE", "971", "0", []int{8, 9}, regexp.MustCompile(`^[2-9]\d{7,8}$`), []string{"5"}},
+	{"BR", "55", "0", []int{10, 11}, regexp.MustCompile(`^[1-9]{2}\d{8,9}$`), nil},
+}
+
+// PhoneRegions is the registry of the numbering plan metadata, the embedded regions are
+// registered at initialization and more regions can be registered at any time.
+var PhoneRegions = &phoneRegionRegistry{}
+
+type phoneRegionRegistry struct {
+	mu            sync.RWMutex
+	byRegion      map[string]*PhoneRegion
+	byCallingCode map[string][]*PhoneRegion
+}
+
+// Register registers the regions, replacing the registered ones of the same region code.
+// The regions sharing a calling code are matched in the order of region code, so +1 is
+// matched by the CA area codes before US.
+func (r *phoneRegionRegistry) Register(regions ...*PhoneRegion) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.byRegion == nil {
+		r.byRegion = make(map[string]*PhoneRegion)
+		r.byCallingCode = make(map[string][]*PhoneRegion)
+	}
+	for _, region := range regions {
+		if old := r.byRegion[region.Region]; old != nil {
+			r.byCallingCode[old.CallingCode] = removePhoneRegion(r.byCallingCode[old.CallingCode], old)
+		}
+		r.byRegion[region.Region] = region
+
+		// copy on write, the slices returned by lookup are never modified
+		shared := r.byCallingCode[region.CallingCode]
+		sorted := make([]*PhoneRegion, 0, len(shared)+1)
+		sorted = append(sorted, shared...)
+		sorted = append(sorted, region)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i].Region < sorted[j].Region })
+		r.byCallingCode[region.CallingCode] = sorted
+	}
+}
+
+// Get returns the region of the ISO 3166-1 alpha-2 code, nil if not registered.
+func (r *phoneRegionRegistry) Get(region string) *PhoneRegion {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.byRegion[region]
+}
+
+// lookup returns the calling code prefix of the international digits and its regions.
+// The calling codes are prefix-free, so at most one of the 1 to 3 digits prefixes matches.
+func (r *phoneRegionRegistry) lookup(digits string) (string, []*PhoneRegion) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for n := 1; n <= 3 && n <= len(digits); n++ {
+		if regions := r.byCallingCode[digits[:n]]; len(regions) > 0 {
+			return digits[:n], regions
+		}
+	}
+	return "", nil
+}
+
+func removePhoneRegion(regions []*PhoneRegion, region *PhoneRegion) []*PhoneRegion {
+	result := make([]*PhoneRegion, 0, len(regions))
+	for _, r := range regions {
+		if r != region {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// phoneNormalizer is the hook set by SetPhoneNormalizer.
+var phoneNormalizer atomic.Pointer[func(number string) string]
+
+// SetPhoneNormalizer sets the optional hook to normalize the raw phone number before parsing,
+// e.g. to convert the full-width digits or remove the extensions. Nil removes the hook.
+func SetPhoneNormalizer(normalize func(number string) string) {
+	if normalize == nil {
+		phoneNormalizer.Store(nil)
+		return
+	}
+	phoneNormalizer.Store(&normalize)
+}
+
+// PhoneNumber is the parsed phone number.
+type PhoneNumber struct {
+	Region      string
+	CallingCode string
+	// National is the national significant number without the trunk prefix.
+	National string
+}
+
+// E164 returns the number in E.164 format like +8613800138000.
+func (n *PhoneNumber) E164() string {
+	return "+" + n.CallingCode + n.National
+}
+
+// IsMobile reports whether the number is a mobile number of its region.
+func (n *PhoneNumber) IsMobile() bool {
+	region := PhoneRegions.Get(n.Region)
+	return region != nil && region.isMobile(n.National)
+}
+
+// phoneDigits removes the formatting characters of the phone number, the international
+// prefix 00 is converted to +.
+func phoneDigits(number string) (string, bool) {
+	if normalize := phoneNormalizer.Load(); normalize != nil {
+		number = (*normalize)(number)
+	}
+
+	var sb strings.Builder
+	for i, c := range strings.TrimSpace(number) {
+		switch {
+		case c >= '0' && c <= '9':
+			sb.WriteRune(c)
+		case c == '+' && i == 0:
+			sb.WriteRune(c)
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')' || c == '/':
+		default:
+			return "", false
+		}
+	}
+	digits := sb.String()
+	if strings.HasPrefix(digits, "00") {
+		digits = "+" + digits[2:]
+	}
+	return digits, true
+}
+
+// ParsePhone parses the phone number in international format like +86 138 0013 8000, or in the
+// national format of the regions like 010-12345678, the first matched region is used.
+// The international number is restricted to the regions if any, the unknown region is an error.
+func ParsePhone(number string, regions ...string) (*PhoneNumber, error) {
+	digits, ok := phoneDigits(number)
+	if !ok {
+		return nil, checkError(ErrInvalidPhone, ErrInvalidCharacters)
+	}
+
+	candidates := make([]*PhoneRegion, 0, len(regions))
+	for _, code := range regions {
+		region := PhoneRegions.Get(code)
+		if region == nil {
+			return nil, ErrUnknownPhoneRegion(code)
+		}
+		candidates = append(candidates, region)
+	}
+
+	if !strings.HasPrefix(digits, "+") {
+		if len(candidates) == 0 {
+			return nil, checkError(ErrInvalidPhone, ErrPhoneRegionRequired)
+		}
+		for _, region := range candidates {
+			if national, ok := region.nationalNumber(digits); ok {
+				return &PhoneNumber{Region: region.Region, CallingCode: region.CallingCode, National: national}, nil
+			}
+		}
+		return nil, checkError(ErrInvalidPhone, ErrInvalidNationalPattern)
+	}
+
+	digits = digits[1:]
+	if !rxE164.MatchString("+" + digits) {
+		return nil, checkError(ErrInvalidPhone, ErrInvalidE164)
+	}
+
+	callingCode, shared := PhoneRegions.lookup(digits)
+	if len(shared) == 0 {
+		return nil, checkError(ErrInvalidPhone, ErrUnknownCallingCode)
+	}
+
+	other := false
+	for _, region := range shared {
+		national, ok := region.nationalNumber(digits[len(callingCode):])
+		if !ok {
+			continue
+		}
+		if len(candidates) > 0 && !containsPhoneRegion(candidates, region) {
+			other = true
+			continue
+		}
+		return &PhoneNumber{Region: region.Region, CallingCode: region.CallingCode, National: national}, nil
+	}
+
+	if other {
+		return nil, checkError(ErrInvalidPhone, ErrPhoneRegionNotAllowed)
+	}
+	return nil, checkError(ErrInvalidPhone, ErrInvalidNationalPattern)
+}
+
+// NormalizePhone parses the phone number like ParsePhone and returns it in E.164 format.
+func NormalizePhone(number string, regions ...string) (string, error) {
+	n, err := ParsePhone(number, regions...)
+	if err != nil {
+		return "", err
+	}
+	return n.E164(), nil
+}
+
+func containsPhoneRegion(regions []*PhoneRegion, region *PhoneRegion) bool {
+	for _, r := range regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// IsE164 check if the string is in E.164 format like +8613800138000. Empty string is valid.
+func IsE164(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" || rxE164.MatchString(str) {
+		return nil
+	}
+	return ErrInvalidE164
+}
+
+// PhoneValidator checks the phone number belongs to the regions, in international format
+// or national format. If Mobile is true, only the mobile numbers are allowed.
+// The validator does not modify the field, use NormalizePhone to get the E.164 format.
+type PhoneValidator struct {
+	Regions []string
+	Mobile  bool
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *PhoneValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	n, err := ParsePhone(str, v.Regions...)
+	if err != nil {
+		return err
+	}
+	if v.Mobile && !n.IsMobile() {
+		return checkError(ErrInvalidPhone, ErrNotMobilePhone)
+	}
+	return nil
+}
+
+// CompilePhone creates the validator of tag `phone(CN,US)` or `phone(CN,mobile)`.
+func CompilePhone(args ...string) Validator {
+	v := &PhoneValidator{}
+	for _, arg := range args {
+		if arg == "mobile" {
+			v.Mobile = true
+			continue
+		}
+		if PhoneRegions.Get(arg) == nil {
+			panic(ErrUnknownPhoneRegion(arg))
+		}
+		v.Regions = append(v.Regions, arg)
+	}
+	return v
+}
+
+func init() {
+	PhoneRegions.Register(phoneRegionData...)
+	TagValidatorMap.RegisterCompileFunc("phone", CompilePhone)
+}
@@ -0,0 +1,155 @@
+package govalidator
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsE164(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"+8613800138000", true},
+		{"+14155552671", true},
+		{"8613800138000", false},
+		{"+0123456789", false},
+		{"+86 138 0013 8000", false},
+		{"+1234567890123456", false},
+	}
+	for _, test := range tests {
+		err := IsE164(test.param)
+		if test.expected {
+			require.NoError(t, err, "check IsE164(%s)", test.param)
+		} else {
+			require.Error(t, err, "check IsE164(%s)", test.param)
+		}
+	}
+}
+
+func TestParsePhone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		number  string
+		regions []string
+		region  string
+		e164    string
+		mobile  bool
+		err     error
+	}{
+		{"+86 138 0013 8000", nil, "CN", "+8613800138000", true, nil},
+		{"0086-138-0013-8000", nil, "CN", "+8613800138000", true, nil},
+		{"13800138000", []string{"CN"}, "CN", "+8613800138000", true, nil},
+		{"010-12345678", []string{"CN"}, "CN", "+861012345678", false, nil},
+		{"0755 1234 5678", []string{"CN"}, "CN", "+8675512345678", false, nil},
+		{"(415) 555-2671", []string{"US"}, "US", "+14155552671", true, nil},
+		{"1-415-555-2671", []string{"US"}, "US", "+14155552671", true, nil},
+		{"+1 416 555 0123", nil, "CA", "+14165550123", true, nil},
+		{"+1 415 555 2671", nil, "US", "+14155552671", true, nil},
+		{"07911 123456", []string{"GB"}, "GB", "+447911123456", true, nil},
+		{"+44 (0)20 7946 0958", nil, "GB", "+442079460958", false, nil},
+		{"8 916 123-45-67", []string{"RU"}, "RU", "+79161234567", true, nil},
+		{"9123 4567", []string{"CN", "HK"}, "HK", "+85291234567", true, nil},
+		{"13800138000", nil, "", "", false, ErrPhoneRegionRequired},
+		{"+999 1234567", nil, "", "", false, ErrUnknownCallingCode},
+		{"+86 12345", nil, "", "", false, ErrInvalidNationalPattern},
+		{"+1 415 555 2671", []string{"CN"}, "", "", false, ErrPhoneRegionNotAllowed},
+		{"138-0013-800a", []string{"CN"}, "", "", false, ErrInvalidCharacters},
+	}
+	for _, test := range tests {
+		n, err := ParsePhone(test.number, test.regions...)
+		if test.err != nil {
+			require.ErrorIs(t, err, test.err, "check ParsePhone(%s)", test.number)
+			require.ErrorIs(t, err, ErrInvalidPhone, "check ParsePhone(%s)", test.number)
+			continue
+		}
+		require.NoError(t, err, "check ParsePhone(%s)", test.number)
+		require.Equal(t, test.region, n.Region, "check ParsePhone(%s)", test.number)
+		require.Equal(t, test.e164, n.E164(), "check ParsePhone(%s)", test.number)
+		require.Equal(t, test.mobile, n.IsMobile(), "check ParsePhone(%s)", test.number)
+	}
+
+	e164, err := NormalizePhone("138 0013 8000", "CN")
+	require.NoError(t, err)
+	require.Equal(t, "+8613800138000", e164)
+
+	_, err = ParsePhone("13800138000", "XX")
+	require.EqualError(t, err, "unknown phone region: XX")
+	require.Panics(t, func() { CompilePhone("XX") })
+}
+
+func TestPhoneNormalizer(t *testing.T) {
+	SetPhoneNormalizer(func(number string) string {
+		if p := strings.Index(number, "ext"); p != -1 {
+			number = number[:p]
+		}
+		return number
+	})
+	defer SetPhoneNormalizer(nil)
+
+	e164, err := NormalizePhone("+1 415 555 2671 ext 123")
+	require.NoError(t, err)
+	require.Equal(t, "+14155552671", e164)
+}
+
+func TestRegisterPhoneRegion(t *testing.T) {
+	t.Parallel()
+
+	PhoneRegions.Register(&PhoneRegion{
+		Region:         "NZ",
+		CallingCode:    "64",
+		TrunkPrefix:    "0",
+		Lengths:        []int{8, 9, 10},
+		Pattern:        regexp.MustCompile(`^[2-9]\d{7,9}$`),
+		MobilePrefixes: []string{"2"},
+	})
+
+	n, err := ParsePhone("+64 21 123 4567", "NZ")
+	require.NoError(t, err)
+	require.Equal(t, "NZ", n.Region)
+	require.True(t, n.IsMobile())
+	require.NoError(t, CompilePhone("NZ").Validate("021 123 4567"))
+
+	// the shared calling code keeps the order of region code
+	n, err = ParsePhone("+1 416 555 0199")
+	require.NoError(t, err)
+	require.Equal(t, "CA", n.Region)
+}
+
+func TestPhoneValidator(t *testing.T) {
+	t.Parallel()
+
+	cn := CompilePhone("CN")
+	cnMobile := CompilePhone("CN", "mobile")
+
+	var tests = []struct {
+		validator Validator
+		param     string
+		expected  error
+	}{
+		{cn, "", nil},
+		{cn, "13800138000", nil},
+		{cn, "+86 10 1234 5678", nil},
+		{cn, "12345", ErrInvalidNationalPattern},
+		{cn, "+1 415 555 2671", ErrPhoneRegionNotAllowed},
+		{cnMobile, "+86 138 0013 8000", nil},
+		{cnMobile, "010-12345678", ErrNotMobilePhone},
+	}
+	for _, test := range tests {
+		err := test.validator.Validate(test.param)
+		if test.expected == nil {
+			require.NoError(t, err, "check phone(%s)", test.param)
+		} else {
+			require.ErrorIs(t, err, test.expected, "check phone(%s)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompilePhone("XX") })
+}
@@ -0,0 +1,76 @@
+package govalidator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ProblemContentType is the media type of the RFC 7807 problem details document.
+const ProblemContentType = "application/problem+json"
+
+// ProblemType is the `type` member of the problem details created by NewProblem.
+var ProblemType = "about:blank"
+
+// Problem is a RFC 7807 problem details document with the `invalid-params` extension.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam is a member of the `invalid-params` extension.
+type InvalidParam struct {
+	Name   string   `json:"name"`
+	Reason string   `json:"reason"`
+	Code   string   `json:"code,omitempty"`
+	Params []string `json:"params,omitempty"`
+}
+
+// NewProblem creates the problem details of the error returned by ValidateStruct.
+func NewProblem(err error) *Problem {
+	p := &Problem{
+		Type:   ProblemType,
+		Title:  http.StatusText(http.StatusBadRequest),
+		Status: http.StatusBadRequest,
+	}
+
+	var errs Errors
+	var e *Error
+	switch {
+	case errors.As(err, &errs):
+		p.InvalidParams = make([]InvalidParam, 0, len(errs))
+		for _, e := range errs {
+			p.InvalidParams = append(p.InvalidParams, e.toInvalidParam())
+		}
+	case errors.As(err, &e):
+		p.InvalidParams = []InvalidParam{e.toInvalidParam()}
+	case err != nil:
+		p.Detail = err.Error()
+	}
+	return p
+}
+
+func (e Error) toInvalidParam() InvalidParam {
+	return InvalidParam{
+		Name:   e.Field(),
+		Reason: e.Message(),
+		Code:   e.Code,
+		Params: e.Params,
+	}
+}
+
+// WriteProblem writes the error as an `application/problem+json` response with status 400.
+func WriteProblem(w http.ResponseWriter, err error) error {
+	data, err := json.Marshal(NewProblem(err))
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(http.StatusBadRequest)
+	_, err = w.Write(data)
+	return err
+}
@@ -1,10 +1,68 @@
 package govalidator
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
+var (
+	ErrInvalidStrIDLength = errors.New("invalid string id length")
+	ErrInvalidStrIDFormat = errors.New("invalid string id format")
+)
+
+func ErrStrIDLength(got, min, max int) error {
+	return fmt.Errorf("%w: should in range [%v, %v], but got %v", ErrInvalidStrIDLength, min, max, got)
+}
+
+func ErrStrIDFormat(err error) error {
+	return fmt.Errorf("%w: %v", ErrInvalidStrIDFormat, err)
+}
+
+func ErrUnknownStrIDPreset(name string) error {
+	return fmt.Errorf("unknown strid preset: %v", name)
+}
+
+// StrIDPresets are the named validators of the common ID shapes, used as `strid(uuid)`.
+// The presets delegate to the identifier validators and report their tags as the error codes,
+// e.g. `strid(ulid)` fails with the code `ulid`.
+var StrIDPresets = map[string]Validator{
+	"uuid":      newStrIDPreset("uuid", IsUUID),
+	"uuid1":     newStrIDPreset("uuid", IsUUID, "1"),
+	"uuid2":     newStrIDPreset("uuid", IsUUID, "2"),
+	"uuid3":     newStrIDPreset("uuid", IsUUID, "3"),
+	"uuid4":     newStrIDPreset("uuid", IsUUID, "4"),
+	"uuid5":     newStrIDPreset("uuid", IsUUID, "5"),
+	"uuid6":     newStrIDPreset("uuid", IsUUID, "6"),
+	"uuid7":     newStrIDPreset("uuid", IsUUID, "7"),
+	"ulid":      newStrIDPreset("ulid", IsULID),
+	"ksuid":     newStrIDPreset("ksuid", IsKSUID),
+	"snowflake": newStrIDPreset("snowflake", IsSnowflake),
+	"nanoid":    NewStrIDValidator(21, 21, `^[A-Za-z0-9_-]{21}$`),
+}
+
+// strIDPreset is the strid preset delegating to the validate func of the tag.
+type strIDPreset struct {
+	tag      string
+	validate ValidateFunc
+	args     []string
+}
+
+func newStrIDPreset(tag string, validate ValidateFunc, args ...string) Validator {
+	return &strIDPreset{tag: tag, validate: validate, args: args}
+}
+
+// Validate implements the Validator interface.
+func (p *strIDPreset) Validate(value interface{}, args ...string) error {
+	return p.validate(value, p.args...)
+}
+
+// code implements the codeReporter interface.
+func (p *strIDPreset) code() string {
+	return p.tag
+}
+
 // StrIDValidator the strid tag validator
 type StrIDValidator struct {
 	RegExValidator Validator
@@ -12,25 +70,61 @@ type StrIDValidator struct {
 	MaxLen         int
 }
 
-// NewStrIDValidator create a new strid validator
+// NewStrIDValidator create a new strid validator, the regex is optional
 func NewStrIDValidator(minLen, maxLen int, regex string) Validator {
 	v := &StrIDValidator{
-		MinLen:         minLen,
-		MaxLen:         maxLen,
-		RegExValidator: NewRegExValidator(regex),
+		MinLen: minLen,
+		MaxLen: maxLen,
+	}
+	if regex != "" {
+		v.RegExValidator = NewRegExValidator(regex)
 	}
 	return v
 }
 
-// Validate implements the Validator interface
+// Validate implements the Validator interface. Empty string is valid.
 func (v *StrIDValidator) Validate(value interface{}, args ...string) error {
-	str, ok := value.(string)
-	if !ok {
-		panic(fmt.Errorf("expected string type, not %v", reflect.TypeOf(value)))
+	val := reflect.ValueOf(value)
+	if val.Kind() != reflect.String {
+		return ErrNotString
+	}
+
+	str := val.String()
+	if str == "" {
+		return nil
 	}
 
 	if len(str) < v.MinLen || len(str) > v.MaxLen {
-		return fmt.Errorf("string length not in [%v,%v]", v.MinLen, v.MaxLen)
+		return ErrStrIDLength(len(str), v.MinLen, v.MaxLen)
 	}
-	return v.RegExValidator.Validate(value)
+
+	if v.RegExValidator != nil {
+		if err := v.RegExValidator.Validate(str); err != nil {
+			return ErrStrIDFormat(err)
+		}
+	}
+	return nil
+}
+
+// CompileStrID creates the validator of tag `strid(min,max[,pattern])` or `strid(preset)`.
+func CompileStrID(args ...string) Validator {
+	switch len(args) {
+	case 0:
+		panic(ErrNumArgsInvalid("strid", 3))
+	case 1:
+		validator, ok := StrIDPresets[args[0]]
+		if !ok {
+			panic(ErrUnknownStrIDPreset(args[0]))
+		}
+		return validator
+	case 2:
+		return NewStrIDValidator(GetInt(args[0]), GetInt(args[1]), "")
+	}
+	// the pattern may contain commas
+	return NewStrIDValidator(GetInt(args[0]), GetInt(args[1]), strings.Join(args[2:], ","))
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("strid", CompileStrID)
+	TagValidatorMap.RegisterParamNames("strid", "min", "max", "pattern")
 }
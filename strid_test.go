@@ -0,0 +1,94 @@
+package govalidator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrIDValidator(t *testing.T) {
+	t.Parallel()
+
+	v := NewStrIDValidator(3, 8, "^[a-z]+$")
+	require.NoError(t, v.Validate(""))
+	require.NoError(t, v.Validate("abc"))
+	require.True(t, errors.Is(v.Validate("ab"), ErrInvalidStrIDLength))
+	require.True(t, errors.Is(v.Validate("abcdefghi"), ErrInvalidStrIDLength))
+	require.True(t, errors.Is(v.Validate("abc1"), ErrInvalidStrIDFormat))
+	require.Equal(t, ErrNotString, v.Validate(123))
+
+	type myID string
+	require.NoError(t, v.Validate(myID("abc")))
+}
+
+func TestStrIDPresets(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		preset   string
+		param    string
+		expected bool
+	}{
+		{"uuid", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"uuid", "f47ac10b-58cc-4372-a567-0e02b2c3d479", true},
+		{"uuid", "f47ac10b-58cc-8372-a567-0e02b2c3d479", true},
+		{"uuid", "f47ac10b-58cc-9372-a567-0e02b2c3d479", false},
+		{"uuid", "f47ac10b-58cc-4372-c567-0e02b2c3d479", false},
+		{"uuid", "f47ac10b58cc4372a5670e02b2c3d479", false},
+		{"uuid1", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"uuid4", "6ba7b810-9dad-11d1-80b4-00c04fd430c8", false},
+		{"uuid4", "f47ac10b-58cc-4372-a567-0e02b2c3d479", true},
+		{"uuid7", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", true},
+		{"ulid", "01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+		{"ulid", "81ARZ3NDEKTSV4RRFFQ69G5FAV", false},
+		{"ulid", "01ARZ3NDEKTSV4RRFFQ69G5FAU", false},
+		{"ksuid", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", true},
+		{"ksuid", "0ujtsYcgvSTl8PAuAdqWYSMnLO", false},
+		{"snowflake", "1541815603606036480", true},
+		{"snowflake", "01541815603606036480", false},
+		{"snowflake", "15418156036060364801", false},
+		{"snowflake", "9223372036854775807", true},
+		{"snowflake", "9223372036854775808", false},
+		{"ksuid", "aWgEPTl1tmebfsQzFP4bxwgy80V", true},
+		{"ksuid", "aWgEPTl1tmebfsQzFP4bxwgy80W", false},
+		{"ksuid", "zzzzzzzzzzzzzzzzzzzzzzzzzzz", false},
+		{"nanoid", "V1StGXR8_Z5jdHi6B-myT", true},
+		{"nanoid", "V1StGXR8_Z5jdHi6B-my=", false},
+	}
+	for _, test := range tests {
+		err := CompileStrID(test.preset).Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check strid(%s) %s", test.preset, test.param)
+		} else {
+			require.Error(t, err, "check strid(%s) %s", test.preset, test.param)
+		}
+	}
+}
+
+type stStrID struct {
+	ID    string `json:"id" valid:"required;strid(uuid4)"`
+	Code  string `json:"code" valid:"strid(2,6,^[A-Z]{2,6}$)"`
+	Token string `json:"token" valid:"strid(4,8)"`
+}
+
+func TestStrIDTag(t *testing.T) {
+	st := &stStrID{ID: "f47ac10b-58cc-4372-a567-0e02b2c3d479", Code: "AB", Token: "abcd"}
+	require.NoError(t, ValidateStruct(st))
+
+	st = &stStrID{ID: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", Code: "abc", Token: "abc"}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Equal(t, []string{"id", "code", "token"}, errs.Fields())
+	require.Equal(t, "uuid", errs.FindByName("id").Code)
+	require.Equal(t, "strid", errs.FindByName("code").Code)
+	require.True(t, errors.Is(errs.FindByName("code"), ErrInvalidStrIDFormat))
+	require.True(t, errors.Is(errs.FindByName("token"), ErrInvalidStrIDLength))
+
+	require.Panics(t, func() {
+		ValidateStruct(&struct {
+			ID string `valid:"strid(unknown)"`
+		}{})
+	})
+}
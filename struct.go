@@ -3,26 +3,84 @@ package govalidator
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/stn81/dynamic"
 )
 
+// TagValidator is a validator parsed from the struct tag.
+// Alias is the name of the alias expanded to the validator, reported as the error code if set.
+// Message is the `~` custom message template, or a MessageCatalog key if prefixed with `@`.
 type TagValidator struct {
 	Name      string
+	Alias     string
+	Field     string
 	CustomErr error
+	Message   string
 	Validator Validator
 	Args      []string
 }
 
 func (v *TagValidator) Validate(value interface{}, args ...string) error {
 	err := v.Validator.Validate(value, v.Args...)
-	if err != nil {
-		if v.CustomErr != nil {
-			err = v.CustomErr
-		}
+	if err == nil || err == ErrSkip {
 		return err
 	}
-	return nil
+	return &Error{
+		Code:   v.code(),
+		Params: append([]string(nil), v.Args...),
+		Err:    v.customize(value, err),
+	}
+}
+
+// codeReporter is implemented by the validators which report an error code other than the tag name.
+type codeReporter interface {
+	code() string
+}
+
+func (v *TagValidator) code() string {
+	if v.Alias != "" {
+		return v.Alias
+	}
+	if reporter, ok := v.Validator.(codeReporter); ok {
+		return reporter.code()
+	}
+	return v.Name
+}
+
+func (v *TagValidator) customize(value interface{}, err error) error {
+	if v.CustomErr != nil {
+		return &CustomError{
+			Message: v.CustomErr.Error(),
+			Custom:  v.CustomErr,
+			Err:     err,
+		}
+	}
+
+	ctx := &ErrorContext{
+		Field: v.Field,
+		Tag:   v.Name,
+		Args:  v.Args,
+		Value: value,
+		Err:   err,
+	}
+
+	if v.Message != "" {
+		template := v.Message
+		if strings.HasPrefix(template, "@") {
+			// the key is checked when the tag is parsed
+			template, _ = MessageCatalog.Get(template[1:])
+		}
+		return &CustomError{
+			Message: RenderMessage(template, ctx),
+			Err:     err,
+		}
+	}
+
+	if factory := TagValidatorMap.GetErrorFactory(v.code()); factory != nil {
+		return factory(ctx)
+	}
+	return err
 }
 
 type DiveValidator struct {
@@ -30,6 +88,10 @@ type DiveValidator struct {
 }
 
 func (v *DiveValidator) Validate(value interface{}, args ...string) error {
+	return v.validateWithOptions(value, defaultOptions)
+}
+
+func (v *DiveValidator) validateWithOptions(value interface{}, opts *options) error {
 	val := reflect.ValueOf(value)
 
 	switch val.Kind() {
@@ -38,23 +100,29 @@ func (v *DiveValidator) Validate(value interface{}, args ...string) error {
 			return nil
 		}
 		ind := reflect.Indirect(val)
-		if err := v.Validator.Validate(ind.Interface()); err != nil {
+		if err := validateWithOptions(v.Validator, ind.Interface(), opts); err != nil {
 			return err
 		}
 	case reflect.Slice, reflect.Array:
 		size := val.Len()
 		for i := 0; i < size; i++ {
 			ind := val.Index(i)
-			if err := v.Validator.Validate(ind.Interface()); err != nil {
-				return err
+			if err := validateWithOptions(v.Validator, ind.Interface(), opts); err != nil {
+				if err == ErrSkip {
+					return err
+				}
+				return withPath(err, PathIndex(i))
 			}
 		}
 	case reflect.Map:
-		keys := val.MapKeys()
+		keys := sortedMapKeys(val)
 		for _, key := range keys {
 			ind := val.MapIndex(key)
-			if err := v.Validator.Validate(ind.Interface()); err != nil {
-				return err
+			if err := validateWithOptions(v.Validator, ind.Interface(), opts); err != nil {
+				if err == ErrSkip {
+					return err
+				}
+				return withPath(err, PathKey(key.Interface()))
 			}
 		}
 	default:
@@ -74,6 +142,10 @@ func (v *DiveValidator) Validate(value interface{}, args ...string) error {
 type DynamicFieldValidator struct{}
 
 func (v *DynamicFieldValidator) Validate(value interface{}, args ...string) error {
+	return v.validateWithOptions(value, defaultOptions)
+}
+
+func (v *DynamicFieldValidator) validateWithOptions(value interface{}, opts *options) error {
 	val := reflect.ValueOf(dynamic.GetValue(value.(*dynamic.Type)))
 	if !val.IsValid() {
 		return nil
@@ -83,12 +155,13 @@ func (v *DynamicFieldValidator) Validate(value interface{}, args ...string) erro
 	if validator == nil {
 		return nil
 	}
-	return validator.Validate(val.Interface())
+	return validateWithOptions(validator, val.Interface(), opts)
 }
 
 type field struct {
 	index      int
 	name       string
+	bail       bool
 	validators []Validator
 }
 
@@ -98,6 +171,10 @@ type structValidator struct {
 }
 
 func (v *structValidator) Validate(value interface{}, args ...string) error {
+	return v.validateWithOptions(value, defaultOptions)
+}
+
+func (v *structValidator) validateWithOptions(value interface{}, opts *options) error {
 	val := reflect.ValueOf(value)
 
 	if val.Kind() != reflect.Struct {
@@ -110,12 +187,18 @@ func (v *structValidator) Validate(value interface{}, args ...string) error {
 		fieldVal := val.Field(field.index)
 	validatorsLoop:
 		for _, validator := range field.validators {
-			err := validator.Validate(fieldVal.Interface())
+			err := validateWithOptions(validator, fieldVal.Interface(), opts.remaining(len(errs)))
 			switch {
 			case err == ErrSkip:
 				break validatorsLoop
 			case err != nil:
 				errs.Append(err, field.name)
+				if opts.limitReached(len(errs)) {
+					return errs[:opts.maxErrors]
+				}
+				if field.bail {
+					break validatorsLoop
+				}
 			}
 		}
 	}
@@ -1,7 +1,6 @@
 package govalidator
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -70,20 +69,40 @@ func (c *structValidatorCache) register(typ reflect.Type) Validator {
 
 		validators := []Validator{}
 		diveCount := 0
+		bail := false
+
+		fieldName := getFieldName(structField)
 
 		// collect Tag Validator
 		if validTag != "" {
 			tags := split(validTag, DefaultTagValueSep)
-			for _, tag := range tags {
-				if tag == "dive" {
+			for _, token := range c.expandAliases(tags, nil) {
+				if token.tag == "dive" {
 					diveCount += 1
 					continue
 				}
-				tagValidator := c.parseTagValidator(tag)
+				if token.tag == "bail" {
+					bail = true
+					continue
+				}
+				tagValidator := c.parseTagValidator(token.tag, fieldName)
+				if token.alias != "" {
+					tagValidator.Alias = token.alias
+				}
+				if token.message != "" {
+					tagValidator.Message = token.message
+				}
+				if key := strings.TrimPrefix(tagValidator.Message, "@"); key != tagValidator.Message {
+					if _, ok := MessageCatalog.Get(key); !ok {
+						panic(ErrUnknownMessageKey(key))
+					}
+				}
+
+				var validator Validator = tagValidator
 				for i := 0; i < diveCount; i++ {
-					tagValidator = &DiveValidator{tagValidator}
+					validator = &DiveValidator{validator}
 				}
-				validators = append(validators, tagValidator)
+				validators = append(validators, validator)
 			}
 		}
 
@@ -103,7 +122,8 @@ func (c *structValidatorCache) register(typ reflect.Type) Validator {
 
 		fi := &field{
 			index:      i,
-			name:       getFieldName(structField),
+			name:       fieldName,
+			bail:       bail,
 			validators: validators,
 		}
 
@@ -118,37 +138,87 @@ func (c *structValidatorCache) register(typ reflect.Type) Validator {
 	return stValidator
 }
 
-func (c *structValidatorCache) parseTagValidator(tag string) Validator {
+// tagToken is a tag of the field after the aliases expanded.
+type tagToken struct {
+	tag     string
+	alias   string
+	message string
+}
+
+// expandAliases expands the aliases in tags in place, parents are the aliases being expanded.
+func (c *structValidatorCache) expandAliases(tags []string, parents []string) []tagToken {
+	tokens := make([]tagToken, 0, len(tags))
+	for _, tag := range tags {
+		name, message := tag, ""
+		if p := strings.Index(tag, "~"); p != -1 {
+			name, message = tag[:p], tag[p+1:]
+		}
+
+		alias := TagValidatorMap.GetAlias(name)
+		if alias == nil {
+			tokens = append(tokens, tagToken{tag: tag})
+			continue
+		}
+
+		for _, parent := range parents {
+			if parent == name {
+				panic(ErrAliasCycle(name))
+			}
+		}
+
+		for _, token := range c.expandAliases(alias.Tags, append(parents, name)) {
+			if alias.Report == ReportAlias {
+				token.alias = name
+			}
+			if message != "" {
+				token.message = message
+			}
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+func (c *structValidatorCache) parseTagValidator(tag string, fieldName string) *TagValidator {
 	var name string
 	var args []string
-	var customErr error
+	var message string
 
-	pCustomErr := strings.Index(tag, "~")
-	if pCustomErr != -1 {
-		customErr = errors.New(tag[pCustomErr+1:])
-		tag = tag[:pCustomErr]
+	pMessage := strings.Index(tag, "~")
+	if pMessage != -1 {
+		message = tag[pMessage+1:]
+		tag = tag[:pMessage]
 	}
 
-	pStart := strings.Index(tag, "(")
+	pStart := strings.IndexAny(tag, "([")
 	if pStart == -1 {
 		name = tag
 	} else {
-		pEnd := strings.Index(tag, ")")
-		if pEnd == -1 {
+		name = tag[:pStart]
+		body := tag[pStart:]
+		switch {
+		case strings.HasPrefix(body, "(") && strings.HasSuffix(body, ")"):
+			args = split(body[1:len(body)-1], ",")
+		case strings.HasSuffix(body, ")") || strings.HasSuffix(body, "]"):
+			// the interval syntax like `range(0,1]`, keep the brackets in args
+			args = split(body, ",")
+		default:
 			panic(ErrUnmatchedParenthesis)
 		}
-		name = tag[:pStart]
-		args = split(tag[pStart+1:pEnd], ",")
 	}
 
 	validator := TagValidatorMap.Get(name)
 	if validator == nil {
 		panic(ErrUnknownTagValidator(name))
 	}
+	if compiler, ok := validator.(Compiler); ok {
+		validator = compiler.Compile(args...)
+	}
 
 	tagValidator := &TagValidator{
 		Name:      name,
-		CustomErr: customErr,
+		Field:     fieldName,
+		Message:   message,
 		Validator: validator,
 		Args:      args,
 	}
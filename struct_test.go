@@ -152,3 +152,174 @@ func TestDynamicField(t *testing.T) {
 	err2 := ValidateStruct(st2)
 	require.NoError(t, err2)
 }
+
+type stFailFast struct {
+	Name  string     `valid:"required"`
+	Value int        `valid:"range(1,3)"`
+	Inner *stEmbeded `valid:"required"`
+	Objs  []*stEmbeded
+}
+
+func TestFailFast(t *testing.T) {
+	st := &stFailFast{Inner: &stEmbeded{0}}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+	require.Len(t, err.(Errors), 3)
+
+	err = ValidateStruct(st, FailFast())
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Len(t, errs, 1)
+	require.NotNil(t, errs.FindByName("Name"))
+
+	st2 := &stFailFast{Name: "a", Value: 1, Inner: &stEmbeded{1}, Objs: []*stEmbeded{{0}}}
+	err = ValidateStruct(st2, FailFast())
+	require.Error(t, err)
+	require.Len(t, err.(Errors), 1)
+	require.Contains(t, err.Error(), "id")
+}
+
+func TestMaxErrors(t *testing.T) {
+	st := &stFailFast{Inner: &stEmbeded{0}}
+	err := ValidateStruct(st, MaxErrors(2))
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Len(t, errs, 2)
+	require.NotNil(t, errs.FindByName("Name"))
+	require.NotNil(t, errs.FindByName("Value"))
+
+	err = ValidateStruct(st, MaxErrors(0))
+	require.Error(t, err)
+	require.Len(t, err.(Errors), 3)
+}
+
+type stBail struct {
+	Name  string `valid:"bail;alpha;length(5,10)"`
+	Other string `valid:"alpha;length(5,10)"`
+}
+
+func TestBail(t *testing.T) {
+	st := &stBail{Name: "123", Other: "123"}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+	errs := err.(Errors)
+	require.Len(t, errs, 3)
+	require.Equal(t, ErrInvalidAlpha, errs.FindByName("Name").Err)
+}
+
+var errBadName = errors.New("bad name")
+
+type stCustomMessage struct {
+	Name  string `json:"name" valid:"length(3,5)~{field} must be {min}-{max} chars, got {value}"`
+	Alias string `json:"alias" valid:"length(3,5)~@user.alias.length"`
+	Code  string `json:"code" valid:"skipempty~never;alpha~{field} [{tag}] {0}{1} {unknown}"`
+}
+
+func TestCustomMessage(t *testing.T) {
+	MessageCatalog.Register("user.alias.length", "别名长度应为{min}到{max}个字符")
+
+	st := &stCustomMessage{Name: "ab", Alias: "ab", Code: "123"}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+
+	errs := err.(Errors)
+	nameErr := errs.FindByName("name")
+	require.Equal(t, "name must be 3-5 chars, got ab", nameErr.Message())
+	require.Equal(t, "length", nameErr.Code)
+	var customErr *CustomError
+	require.True(t, errors.As(nameErr, &customErr))
+	require.Equal(t, ErrInvalidLength(2, 3, 5), customErr.Err)
+
+	require.Equal(t, "别名长度应为3到5个字符", errs.FindByName("alias").Message())
+	require.Equal(t, "code [alpha] {0}{1} {unknown}", errs.FindByName("code").Message())
+	require.True(t, errors.Is(errs.FindByName("code"), ErrInvalidAlpha))
+
+	type stMissingMessage struct {
+		Nick string `json:"nick" valid:"length(3,5)~@missing.key"`
+	}
+	require.PanicsWithError(t, ErrUnknownMessageKey("missing.key").Error(), func() {
+		_ = ValidateStruct(&stMissingMessage{Nick: "ab"})
+	})
+}
+
+func TestCustomErr(t *testing.T) {
+	v := &TagValidator{
+		Name:      "alpha",
+		CustomErr: errBadName,
+		Validator: ValidateFunc(IsAlpha),
+	}
+	err := v.Validate("123")
+	require.Error(t, err)
+	require.Equal(t, "bad name", err.Error())
+	require.True(t, errors.Is(err, errBadName))
+	require.True(t, errors.Is(err, ErrInvalidAlpha))
+}
+
+type stErrorFactory struct {
+	Value int `valid:"min(10)"`
+}
+
+func TestErrorFactory(t *testing.T) {
+	TagValidatorMap.RegisterErrorFactory("min", func(ctx *ErrorContext) error {
+		return &CustomError{
+			Message: RenderMessage("{field} 不能小于 {min}", ctx),
+			Err:     ctx.Err,
+		}
+	})
+	defer TagValidatorMap.UnregisterErrorFactory("min")
+
+	err := ValidateStruct(&stErrorFactory{Value: 1})
+	require.Error(t, err)
+	require.Equal(t, "Value: Value 不能小于 10", err.Error())
+}
+
+func init() {
+	TagValidatorMap.RegisterAlias("test_username", "skipempty;length(3,8);regex(^[a-z][a-z0-9_]*$)")
+	TagValidatorMap.RegisterAlias("test_nickname", "length(3,8);alpha", ReportAlias)
+	TagValidatorMap.RegisterAlias("test_names", "dive;test_username")
+	TagValidatorMap.RegisterAlias("test_cycle_a", "test_cycle_b")
+	TagValidatorMap.RegisterAlias("test_cycle_b", "test_cycle_a")
+}
+
+type stAlias struct {
+	Name     string   `json:"name" valid:"required;test_username"`
+	Nick     string   `json:"nick" valid:"test_nickname"`
+	Names    []string `json:"names" valid:"dive;test_username"`
+	Others   []string `json:"others" valid:"test_names"`
+	Optional string   `json:"optional" valid:"test_username~{field} is not a valid username"`
+}
+
+func TestTagAlias(t *testing.T) {
+	st := &stAlias{
+		Name:     "a",
+		Nick:     "1",
+		Names:    []string{"abc", "1abc"},
+		Others:   []string{"abc", "toolongname"},
+		Optional: "_abc",
+	}
+	err := ValidateStruct(st)
+	require.Error(t, err)
+
+	errs := err.(Errors)
+	require.Equal(t, []string{"name", "nick", "names[1]", "others[1]", "optional"}, errs.Fields())
+	require.Equal(t, "length", errs.FindByName("name").Code)
+	require.Len(t, errs.ByField("nick"), 2)
+	require.Equal(t, "test_nickname", errs.ByField("nick")[0].Code)
+	require.Equal(t, "test_nickname", errs.ByField("nick")[1].Code)
+	require.Equal(t, "regex", errs.FindByName("names[1]").Code)
+	require.Equal(t, "length", errs.FindByName("others[1]").Code)
+	require.Equal(t, "optional is not a valid username", errs.FindByName("optional").Message())
+
+	st2 := &stAlias{Name: "abc", Nick: "abc"}
+	require.NoError(t, ValidateStruct(st2))
+}
+
+func TestTagAliasCycle(t *testing.T) {
+	defer func() {
+		r := recover()
+		require.Equal(t, ErrAliasCycle("test_cycle_a"), r)
+	}()
+	ValidateStruct(&struct {
+		Name string `valid:"test_cycle_a"`
+	}{})
+}
@@ -7,7 +7,27 @@ import (
 var TagValidatorMap = &tagValidatorMap{}
 
 type tagValidatorMap struct {
-	store sync.Map
+	store     sync.Map
+	aliases   sync.Map
+	factories sync.Map
+	params    sync.Map
+}
+
+// AliasReport configures the error code reported by the validators expanded from an alias.
+type AliasReport int
+
+const (
+	// ReportRule reports the errors under the name of the underlying rule, the default.
+	ReportRule AliasReport = iota
+	// ReportAlias reports the errors under the alias name.
+	ReportAlias
+)
+
+// TagAlias is a named rule chain expanded in place when the struct tag is parsed.
+type TagAlias struct {
+	Name   string
+	Tags   []string
+	Report AliasReport
 }
 
 func (m *tagValidatorMap) Get(name string) Validator {
@@ -25,3 +45,66 @@ func (m *tagValidatorMap) RegisterValidator(name string, validator Validator) {
 func (m *tagValidatorMap) RegisterValidateFunc(name string, validateFunc ValidateFunc) {
 	m.RegisterValidator(name, validateFunc)
 }
+
+// RegisterCompileFunc registers a validator compiled from the tag arguments when the tag is parsed.
+func (m *tagValidatorMap) RegisterCompileFunc(name string, compileFunc CompileFunc) {
+	m.RegisterValidator(name, compileFunc)
+}
+
+// RegisterAlias registers name as the shorthand of the rule chain tags, e.g.
+// RegisterAlias("username", "skipempty;length(3,32);regex(^[a-z][a-z0-9_]*$)").
+// The errors are reported under the underlying rule unless ReportAlias is given.
+// The alias must be registered before the struct types using it are validated.
+func (m *tagValidatorMap) RegisterAlias(name, tags string, report ...AliasReport) {
+	alias := &TagAlias{
+		Name: name,
+		Tags: split(tags, DefaultTagValueSep),
+	}
+	if len(report) > 0 {
+		alias.Report = report[0]
+	}
+	m.aliases.Store(name, alias)
+}
+
+// GetAlias returns the alias of name.
+func (m *tagValidatorMap) GetAlias(name string) *TagAlias {
+	value, ok := m.aliases.Load(name)
+	if !ok {
+		return nil
+	}
+	return value.(*TagAlias)
+}
+
+// RegisterErrorFactory registers the factory creating the errors of the tag validator,
+// it is used when the tag has no `~` custom message.
+func (m *tagValidatorMap) RegisterErrorFactory(name string, factory ErrorFactory) {
+	m.factories.Store(name, factory)
+}
+
+// UnregisterErrorFactory removes the error factory of the tag validator.
+func (m *tagValidatorMap) UnregisterErrorFactory(name string) {
+	m.factories.Delete(name)
+}
+
+// GetErrorFactory returns the error factory of the tag validator.
+func (m *tagValidatorMap) GetErrorFactory(name string) ErrorFactory {
+	value, ok := m.factories.Load(name)
+	if !ok {
+		return nil
+	}
+	return value.(ErrorFactory)
+}
+
+// RegisterParamNames registers the argument names of the tag validator used as message placeholders.
+func (m *tagValidatorMap) RegisterParamNames(name string, params ...string) {
+	m.params.Store(name, params)
+}
+
+// GetParamNames returns the argument names of the tag validator.
+func (m *tagValidatorMap) GetParamNames(name string) []string {
+	value, ok := m.params.Load(name)
+	if !ok {
+		return nil
+	}
+	return value.([]string)
+}
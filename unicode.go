@@ -0,0 +1,209 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// The units of the length tag, e.g. `length(1,10,graphemes)`.
+const (
+	LengthBytes     = "bytes"
+	LengthRunes     = "runes"
+	LengthGraphemes = "graphemes"
+)
+
+var (
+	ErrInvalidUTFLetterNumeric = errors.New("invalid UTF letter or numeric")
+	ErrInvalidUTFDigit         = errors.New("invalid UTF digit")
+	ErrNotNFC                  = errors.New("not NFC normalized")
+	ErrHasControlChar          = errors.New("has control character")
+	ErrHasBidiControl          = errors.New("has bidi control character")
+)
+
+func ErrInvalidScript(scripts ...string) error {
+	return fmt.Errorf("should only contain scripts: [%v]", strings.Join(scripts, ","))
+}
+
+func ErrUnknownScript(name string) error {
+	return fmt.Errorf("unknown script: %v", name)
+}
+
+func ErrUnknownLengthUnit(unit string) error {
+	return fmt.Errorf("unknown length unit: %v", unit)
+}
+
+// stringLength returns the length of str in unit, bytes, runes or graphemes.
+func stringLength(str string, unit string) int {
+	switch unit {
+	case LengthBytes:
+		return len(str)
+	case LengthGraphemes:
+		return uniseg.GraphemeClusterCount(str)
+	}
+	return utf8.RuneCountInString(str)
+}
+
+// LengthValidator checks the length of string or []byte is between Min and Max. The string is
+// counted in Unit, the []byte is always counted in bytes, the Unit is ignored.
+type LengthValidator struct {
+	Min  int
+	Max  int
+	Unit string
+}
+
+// Validate implements the Validator interface.
+func (v *LengthValidator) Validate(value interface{}, args ...string) error {
+	var length int
+	switch val := value.(type) {
+	case string:
+		length = stringLength(val, v.Unit)
+	case []byte:
+		length = len(val)
+	}
+
+	if length >= v.Min && length <= v.Max {
+		return nil
+	}
+	return ErrInvalidLength(length, v.Min, v.Max)
+}
+
+// CompileLength creates the validator of tag `length(1,10)` or `length(1,10,graphemes)`.
+func CompileLength(args ...string) Validator {
+	if len(args) != 2 && len(args) != 3 {
+		panic(ErrNumArgsInvalid("length", 2))
+	}
+
+	v := &LengthValidator{Min: GetInt(args[0]), Max: GetInt(args[1]), Unit: LengthRunes}
+	if len(args) == 3 {
+		switch unit := strings.TrimSpace(args[2]); unit {
+		case LengthBytes, LengthRunes, LengthGraphemes:
+			v.Unit = unit
+		default:
+			panic(ErrUnknownLengthUnit(args[2]))
+		}
+	}
+	return v
+}
+
+// IsUTFLetter check if the string contains only unicode letters, the combining marks are allowed.
+// Empty string is valid.
+func IsUTFLetter(value interface{}, args ...string) error {
+	str := assertString(value)
+	for i, r := range str {
+		if unicode.IsLetter(r) || (i > 0 && unicode.IsMark(r)) {
+			continue
+		}
+		return ErrInvalidUTFLetter
+	}
+	return nil
+}
+
+// IsUTFLetterNumeric check if the string contains only unicode letters and numbers, the combining
+// marks are allowed. Empty string is valid.
+func IsUTFLetterNumeric(value interface{}, args ...string) error {
+	str := assertString(value)
+	for i, r := range str {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || (i > 0 && unicode.IsMark(r)) {
+			continue
+		}
+		return ErrInvalidUTFLetterNumeric
+	}
+	return nil
+}
+
+// IsUTFDigit check if the string contains only unicode decimal digits. Empty string is valid.
+func IsUTFDigit(value interface{}, args ...string) error {
+	str := assertString(value)
+	for _, r := range str {
+		if !unicode.IsDigit(r) {
+			return ErrInvalidUTFDigit
+		}
+	}
+	return nil
+}
+
+// IsNFC check if the string is in unicode normalization form C. Empty string is valid.
+func IsNFC(value interface{}, args ...string) error {
+	str := assertString(value)
+	if norm.NFC.IsNormalString(str) {
+		return nil
+	}
+	return ErrNotNFC
+}
+
+// NoControl check if the string contains no control character, including tab and newline.
+// Empty string is valid.
+func NoControl(value interface{}, args ...string) error {
+	str := assertString(value)
+	if strings.IndexFunc(str, unicode.IsControl) == -1 {
+		return nil
+	}
+	return ErrHasControlChar
+}
+
+// NoBidi check if the string contains no bidi embedding, override or isolate character,
+// which may reorder the displayed text. Empty string is valid.
+func NoBidi(value interface{}, args ...string) error {
+	str := assertString(value)
+	if strings.IndexFunc(str, isBidiControl) == -1 {
+		return nil
+	}
+	return ErrHasBidiControl
+}
+
+func isBidiControl(r rune) bool {
+	return ('\u202a' <= r && r <= '\u202e') || ('\u2066' <= r && r <= '\u2069')
+}
+
+// ScriptValidator checks the letters of the string belong to the scripts, the characters
+// common to all scripts like digits, spaces and punctuations are allowed.
+type ScriptValidator struct {
+	Names   []string
+	Scripts []*unicode.RangeTable
+}
+
+// NewScriptValidator creates the script validator of the unicode script names, e.g. Han, Latin.
+func NewScriptValidator(names ...string) *ScriptValidator {
+	v := &ScriptValidator{
+		Names:   names,
+		Scripts: []*unicode.RangeTable{unicode.Common, unicode.Inherited},
+	}
+	for _, name := range names {
+		script, ok := unicode.Scripts[name]
+		if !ok {
+			panic(ErrUnknownScript(name))
+		}
+		v.Scripts = append(v.Scripts, script)
+	}
+	return v
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *ScriptValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	for _, r := range str {
+		if !unicode.In(r, v.Scripts...) {
+			return ErrInvalidScript(v.Names...)
+		}
+	}
+	return nil
+}
+
+// CompileScript creates the validator of tag `script(Han,Latin)`.
+func CompileScript(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("script", 1))
+	}
+	return NewScriptValidator(args...)
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("length", CompileLength)
+	TagValidatorMap.RegisterCompileFunc("script", CompileScript)
+}
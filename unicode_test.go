@@ -0,0 +1,136 @@
+package govalidator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnicodeClasses(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		letter   bool
+		letterNo bool
+		digit    bool
+	}{
+		{"", true, true, true},
+		{"abc", true, true, false},
+		{"世界", true, true, false},
+		{"Ünïcödé", true, true, false},
+		{"e\u0301", true, true, false},
+		{"\u0301e", false, false, false},
+		{"abc123", false, true, false},
+		{"四五Ⅻ", false, true, false},
+		{"١٢٣", false, true, true},
+		{"123", false, true, true},
+		{"a b", false, false, false},
+		{"a-b", false, false, false},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%s)", name, test.param)
+			} else {
+				require.Error(t, err, "check %s(%s)", name, test.param)
+			}
+		}
+		check(IsUTFLetter(test.param), test.letter, "utf_letter")
+		check(IsUTFLetterNumeric(test.param), test.letterNo, "utf_letter_num")
+		check(IsUTFDigit(test.param), test.digit, "utf_digit")
+	}
+}
+
+func TestScript(t *testing.T) {
+	t.Parallel()
+
+	v := CompileScript("Han", "Latin")
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"张三", true},
+		{"Zhang San", true},
+		{"张三 Zhang, 2024!", true},
+		{"Ivan Иван", false},
+		{"さくら", false},
+	}
+	for _, test := range tests {
+		err := v.Validate(test.param)
+		if test.expected {
+			require.NoError(t, err, "check script(Han,Latin)(%s)", test.param)
+		} else {
+			require.Error(t, err, "check script(Han,Latin)(%s)", test.param)
+		}
+	}
+
+	require.Panics(t, func() { CompileScript("Klingon") })
+	require.Panics(t, func() { CompileScript() })
+}
+
+func TestNormalizationAndControls(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param     string
+		nfc       bool
+		noControl bool
+		noBidi    bool
+	}{
+		{"", true, true, true},
+		{"caf\u00e9", true, true, true},
+		{"cafe\u0301", false, true, true},
+		{"a\tb", true, false, true},
+		{"a\nb", true, false, true},
+		{"a\x00b", true, false, true},
+		{"a\u0085b", true, false, true},
+		{"admin\u202egnp.exe", true, true, false},
+		{"a\u2067b\u2069", true, true, false},
+		{"a\u200eb", true, true, true},
+	}
+	for _, test := range tests {
+		check := func(err error, expected bool, name string) {
+			if expected {
+				require.NoError(t, err, "check %s(%q)", name, test.param)
+			} else {
+				require.Error(t, err, "check %s(%q)", name, test.param)
+			}
+		}
+		check(IsNFC(test.param), test.nfc, "nfc")
+		check(NoControl(test.param), test.noControl, "no_control")
+		check(NoBidi(test.param), test.noBidi, "no_bidi")
+	}
+}
+
+func TestLengthUnit(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		value  string
+		unit   string
+		length int
+	}{
+		{"abc", "bytes", 3},
+		{"世界", "bytes", 6},
+		{"世界", "runes", 2},
+		{"e\u0301", "runes", 2},
+		{"e\u0301", "graphemes", 1},
+		{"👍🏽", "runes", 2},
+		{"👍🏽", "graphemes", 1},
+		{"🇨🇳🇺🇸", "graphemes", 2},
+	}
+	for _, test := range tests {
+		n := fmt.Sprint(test.length)
+		require.NoError(t, Length(test.value, n, n, test.unit), "check Length(%s, %s)", test.value, test.unit)
+		require.Error(t, Length(test.value, "0", fmt.Sprint(test.length-1), test.unit), "check Length(%s, %s)", test.value, test.unit)
+	}
+
+	require.Panics(t, func() { _ = Length("abc", "1", "3", "words") })
+	require.Panics(t, func() { CompileLength("1", "10", "grapheme") })
+	require.NoError(t, CompileLength("3", "3", "graphemes").Validate([]byte("e\u0301")), "the []byte is counted in bytes")
+	require.Error(t, CompileLength("1", "1", "graphemes").Validate([]byte("e\u0301")))
+}
@@ -0,0 +1,224 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrURLHasUserInfo = errors.New("url should not contain user info")
+	ErrURLHasQuery    = errors.New("url should not contain query")
+	ErrURLHasFragment = errors.New("url should not contain fragment")
+	ErrInvalidURI     = errors.New("invalid URI")
+	ErrInvalidURN     = errors.New("invalid URN")
+)
+
+func ErrURLSchemeNotAllowed(scheme string, allowed []string) error {
+	return fmt.Errorf("url scheme %v not in list: [%v]", scheme, strings.Join(allowed, ","))
+}
+
+func ErrURLHostNotAllowed(host string, allowed []string) error {
+	return fmt.Errorf("url host %v not in list: [%v]", host, strings.Join(allowed, ","))
+}
+
+func ErrUnknownURLOption(option string) error {
+	return fmt.Errorf("unknown url option: %v", option)
+}
+
+var (
+	rxURIScheme = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.\-]*$`)
+	rxURIChars  = regexp.MustCompile(`^(?:[A-Za-z0-9\-._~:/?#\[\]@!$&'()*+,;=]|%[0-9A-Fa-f]{2})*$`)
+	rxURN       = regexp.MustCompile(`^(?i:urn):[A-Za-z0-9][A-Za-z0-9\-]{0,30}[A-Za-z0-9]:(?:[A-Za-z0-9\-._~!$&'()*+,;=:@/]|%[0-9A-Fa-f]{2})+(?:\?\+(?:[A-Za-z0-9\-._~!$&'()*+,;=:@/?]|%[0-9A-Fa-f]{2})+)?(?:\?=(?:[A-Za-z0-9\-._~!$&'()*+,;=:@/?]|%[0-9A-Fa-f]{2})+)?(?:#(?:[A-Za-z0-9\-._~!$&'()*+,;=:@/?]|%[0-9A-Fa-f]{2})*)?$`)
+)
+
+// URLValidator validates the absolute URL with host, parsed by net/url.
+// Hosts are the allowed hosts, `*.example.com` matches the subdomains of example.com.
+type URLValidator struct {
+	Schemes    []string
+	Hosts      []string
+	NoUser     bool
+	NoQuery    bool
+	NoFragment bool
+}
+
+// NewURLValidator creates the url validator from the options:
+// scheme=https|wss, host=a.com|*.b.com, nouser, noquery, nofragment.
+func NewURLValidator(options ...string) *URLValidator {
+	v := &URLValidator{}
+	for _, option := range options {
+		name, value := option, ""
+		if p := strings.IndexByte(option, '='); p != -1 {
+			name, value = option[:p], option[p+1:]
+		}
+
+		switch name {
+		case "scheme":
+			for _, scheme := range split(value, "|") {
+				v.Schemes = append(v.Schemes, strings.ToLower(scheme))
+			}
+		case "host":
+			for _, host := range split(value, "|") {
+				v.Hosts = append(v.Hosts, strings.ToLower(host))
+			}
+		case "nouser":
+			v.NoUser = true
+		case "noquery":
+			v.NoQuery = true
+		case "nofragment":
+			v.NoFragment = true
+		default:
+			panic(ErrUnknownURLOption(option))
+		}
+	}
+	return v
+}
+
+// Validate implements the Validator interface. Empty string is valid.
+func (v *URLValidator) Validate(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	u, err := parseAbsoluteURL(str)
+	if err != nil {
+		return err
+	}
+
+	if len(v.Schemes) > 0 && !containsString(v.Schemes, u.Scheme) {
+		return ErrURLSchemeNotAllowed(u.Scheme, v.Schemes)
+	}
+	if len(v.Hosts) > 0 && !matchHost(v.Hosts, u.Hostname()) {
+		return ErrURLHostNotAllowed(u.Hostname(), v.Hosts)
+	}
+	if v.NoUser && u.User != nil {
+		return ErrURLHasUserInfo
+	}
+	if v.NoQuery && (u.RawQuery != "" || u.ForceQuery) {
+		return ErrURLHasQuery
+	}
+	if v.NoFragment && (u.Fragment != "" || strings.Contains(str, "#")) {
+		return ErrURLHasFragment
+	}
+	return nil
+}
+
+// parseAbsoluteURL parses the url with scheme and a valid host.
+func parseAbsoluteURL(str string) (*url.URL, error) {
+	if utf8.RuneCountInString(str) >= maxURLRuneCount {
+		return nil, ErrInvalidURL
+	}
+
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" || u.Opaque != "" {
+		return nil, ErrInvalidURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	host := u.Hostname()
+	switch {
+	case host == "":
+		return nil, ErrInvalidURL
+	case strings.HasPrefix(u.Host, "["):
+		if net.ParseIP(host) == nil {
+			return nil, ErrInvalidURL
+		}
+	case net.ParseIP(host) == nil:
+		ascii, ok := toASCIIDomain(host)
+		if !ok || !isHostname(strings.TrimSuffix(ascii, ".")) {
+			return nil, ErrInvalidURL
+		}
+	}
+
+	if port := u.Port(); port != "" && IsPort(port) != nil {
+		return nil, ErrInvalidURL
+	}
+	return u, nil
+}
+
+func matchHost(hosts []string, host string) bool {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+		if strings.HasPrefix(h, "*.") && strings.HasSuffix(host, h[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(list []string, str string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileURL creates the validator of tag `url(...)`, see NewURLValidator for the options.
+// Without options, the tag is validated by IsURL.
+func CompileURL(args ...string) Validator {
+	if len(args) == 0 {
+		return ValidateFunc(IsURL)
+	}
+	return NewURLValidator(args...)
+}
+
+// CompileURLHostIn creates the validator of tag `url_host_in(a.com,*.b.com)`.
+func CompileURLHostIn(args ...string) Validator {
+	if len(args) == 0 {
+		panic(ErrNumArgsInvalid("url_host_in", 1))
+	}
+	return NewURLValidator("host=" + strings.Join(args, "|"))
+}
+
+var httpURLValidator = NewURLValidator("scheme=http|https")
+
+// IsHTTPURL check if the string is an absolute http or https URL. Empty string is valid.
+func IsHTTPURL(value interface{}, args ...string) error {
+	return httpURLValidator.Validate(value)
+}
+
+// IsURI check if the string is a RFC 3986 URI, the scheme is required. Empty string is valid.
+func IsURI(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	p := strings.IndexByte(str, ':')
+	if p <= 0 || !rxURIScheme.MatchString(str[:p]) || !rxURIChars.MatchString(str) {
+		return ErrInvalidURI
+	}
+	if _, err := url.Parse(str); err != nil {
+		return ErrInvalidURI
+	}
+	return nil
+}
+
+// IsURN check if the string is a RFC 8141 URN. Empty string is valid.
+func IsURN(value interface{}, args ...string) error {
+	str := assertString(value)
+	if str == "" {
+		return nil
+	}
+
+	if rxURN.MatchString(str) {
+		return nil
+	}
+	return ErrInvalidURN
+}
+
+func init() {
+	TagValidatorMap.RegisterCompileFunc("url", CompileURL)
+	TagValidatorMap.RegisterCompileFunc("url_host_in", CompileURLHostIn)
+	TagValidatorMap.RegisterParamNames("url_host_in", "hosts")
+}
@@ -19,7 +19,85 @@ func (f ValidateFunc) Validate(value interface{}, args ...string) error {
 	return f(value, args...)
 }
 
-func ValidateStruct(ptr interface{}) error {
+// Option configures a single ValidateStruct call.
+type Option func(*options)
+
+type options struct {
+	maxErrors int
+}
+
+var defaultOptions = &options{}
+
+// FailFast stops the validation at the first error found in the whole struct tree.
+func FailFast() Option {
+	return MaxErrors(1)
+}
+
+// MaxErrors stops the validation once n errors are collected, n <= 0 means no limit.
+func MaxErrors(n int) Option {
+	return func(o *options) {
+		if n < 0 {
+			n = 0
+		}
+		o.maxErrors = n
+	}
+}
+
+// remaining returns the options for a nested validation after used errors are collected.
+func (o *options) remaining(used int) *options {
+	if o.maxErrors == 0 || used == 0 {
+		return o
+	}
+	sub := *o
+	sub.maxErrors = o.maxErrors - used
+	return &sub
+}
+
+// limitReached checks whether the collected errors reach the max errors limit.
+func (o *options) limitReached(n int) bool {
+	return o.maxErrors > 0 && n >= o.maxErrors
+}
+
+// optionsValidator is implemented by the validators which pass the call options down to nested structs.
+type optionsValidator interface {
+	validateWithOptions(value interface{}, opts *options) error
+}
+
+func validateWithOptions(validator Validator, value interface{}, opts *options) error {
+	if v, ok := validator.(optionsValidator); ok {
+		return v.validateWithOptions(value, opts)
+	}
+	return validator.Validate(value)
+}
+
+func ValidateStruct(ptr interface{}, opts ...Option) error {
 	validator := structValidators.get(ptr)
-	return validator.Validate(ptr)
+
+	o := defaultOptions
+	if len(opts) > 0 {
+		o = &options{}
+		for _, opt := range opts {
+			opt(o)
+		}
+	}
+	return validateWithOptions(validator, ptr, o)
+}
+
+// Compiler is implemented by the validators which prepare themselves from the tag
+// arguments once, when the struct tag is parsed, instead of on every validation.
+type Compiler interface {
+	Compile(args ...string) Validator
+}
+
+// CompileFunc creates a validator from the tag arguments.
+type CompileFunc func(args ...string) Validator
+
+// Validate implements the Validator interface, the arguments are compiled on every call.
+func (f CompileFunc) Validate(value interface{}, args ...string) error {
+	return f(args...).Validate(value)
+}
+
+// Compile implements the Compiler interface.
+func (f CompileFunc) Compile(args ...string) Validator {
+	return f(args...)
 }